@@ -0,0 +1,237 @@
+package main
+
+import (
+	"go/token"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// diagnostic is a problem found in the program.
+type diagnostic struct {
+	pos     token.Pos
+	message string
+}
+
+// check reports duplicate components, missing dependencies and ambiguous interface bindings of every container.
+// Missing dependencies are only reported for containers which components are completely known.
+func (prog *program) check() []diagnostic {
+	var (
+		diags      []diagnostic
+		duplicated = make(map[*component]bool)
+	)
+
+	report := func(pos token.Pos, message string) {
+		diags = append(diags, diagnostic{pos: pos, message: message})
+	}
+
+	for _, key := range prog.order {
+		c := prog.containers[key]
+		comps, complete := prog.components(key, make(map[types.Object]bool))
+
+		for _, dup := range duplicates(comps) {
+			// duplicate in a module is reported once, not for every container it's installed to.
+			if !duplicated[dup[1]] {
+				duplicated[dup[1]] = true
+				report(dup[1].pos, "duplicate component "+dup[1].String()+" connected to "+c.name+
+					", previously connected at "+prog.position(dup[0].pos))
+			}
+		}
+
+		// module dependencies might be provided by the container it's installed to.
+		if c.module {
+			continue
+		}
+
+		for _, comp := range comps {
+			// connecting duplicate panics before it's dependencies are wired.
+			if duplicated[comp] {
+				continue
+			}
+
+			for _, dep := range comp.deps {
+				matches := resolve(comps, dep)
+				switch {
+				case len(matches) == 0 && complete && !dep.hasDefault:
+					report(comp.pos, "missing dependency for field "+dep.field+" of "+comp.String()+
+						" in "+c.name+", requires "+types.TypeString(dep.typ, nil)+" "+quote(dep.id)+knownIDs(comps, dep.typ))
+				case len(matches) > 1:
+					names := make([]string, len(matches))
+					for i, m := range matches {
+						names[i] = m.String()
+					}
+
+					report(comp.pos, "ambiguous dependency for field "+dep.field+" of "+comp.String()+
+						" in "+c.name+", "+types.TypeString(dep.typ, nil)+" is implemented by "+strings.Join(names, ", "))
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(diags, func(i, j int) bool {
+		a, b := prog.fset.Position(diags[i].pos), prog.fset.Position(diags[j].pos)
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+
+		return a.Offset < b.Offset
+	})
+
+	return diags
+}
+
+// components collects components of container and the modules or containers included into it,
+// complete reports whether all of them are known.
+func (prog *program) components(key types.Object, visited map[types.Object]bool) ([]*component, bool) {
+	c, ok := prog.containers[key]
+	if !ok || visited[key] {
+		return nil, ok
+	}
+
+	visited[key] = true
+	comps := append([]*component(nil), c.components...)
+	complete := c.created && !c.incomplete
+
+	for _, include := range c.includes {
+		included, ok := prog.components(include, visited)
+		comps = append(comps, included...)
+		complete = complete && ok
+	}
+
+	return replaced(comps), complete
+}
+
+// replaced removes components replaced by a later Replace call.
+func replaced(comps []*component) []*component {
+	var result []*component
+
+	for i, comp := range comps {
+		superseded := false
+		for _, later := range comps[i+1:] {
+			if later.replace && !later.dynamicID && !comp.dynamicID && later.id == comp.id && types.Identical(later.typ, comp.typ) {
+				superseded = true
+				break
+			}
+		}
+
+		if !superseded {
+			result = append(result, comp)
+		}
+	}
+
+	return result
+}
+
+// duplicates finds pairs of components with the same type and id that are always connected together.
+func duplicates(comps []*component) [][2]*component {
+	var dups [][2]*component
+
+	for i, b := range comps {
+		if b.replace || b.conditional || b.dynamicID {
+			continue
+		}
+
+		for _, a := range comps[:i] {
+			if !a.conditional && !a.dynamicID && a.id == b.id && types.Identical(a.typ, b.typ) {
+				dups = append(dups, [2]*component{a, b})
+				break
+			}
+		}
+	}
+
+	return dups
+}
+
+// resolve finds components that might be wired into dep the same way wire does at runtime,
+// conditional components are only counted when nothing else matches.
+// Like wire, interface dependency only skips scanning implementations when a component of the interface type has dep's id.
+func resolve(comps []*component, dep dependency) []*component {
+	var (
+		exact        bool
+		matches      []*component
+		conditionals []*component
+	)
+
+	for _, comp := range comps {
+		if types.Identical(comp.typ, dep.typ) && (!types.IsInterface(dep.typ) || comp.id == dep.id || comp.dynamicID) {
+			exact = true
+		}
+	}
+
+	for _, comp := range comps {
+		if exact && !types.Identical(comp.typ, dep.typ) {
+			continue
+		}
+
+		if !exact {
+			if !types.IsInterface(dep.typ) || types.IsInterface(comp.typ) || !implements(comp, dep) {
+				continue
+			}
+		}
+
+		if comp.id != dep.id && !comp.dynamicID {
+			continue
+		}
+
+		if comp.conditional || comp.dynamicID {
+			conditionals = append(conditionals, comp)
+		} else if !contains(matches, comp.typ) {
+			matches = append(matches, comp)
+		}
+	}
+
+	if len(matches) == 0 {
+		return conditionals
+	}
+
+	return matches
+}
+
+func implements(comp *component, dep dependency) bool {
+	if dep.impl != "" {
+		named, ok := comp.typ.(*types.Named)
+		if !ok || named.Obj().Name() != dep.impl {
+			return false
+		}
+	}
+
+	iface := dep.typ.Underlying().(*types.Interface)
+	return types.Implements(comp.typ, iface) || types.Implements(types.NewPointer(comp.typ), iface)
+}
+
+// contains reports whether a component with typ is already matched, replaced components share a type.
+func contains(comps []*component, typ types.Type) bool {
+	for _, c := range comps {
+		if types.Identical(c.typ, typ) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// knownIDs describes ids connected for typ to help spotting a typo.
+func knownIDs(comps []*component, typ types.Type) string {
+	var (
+		ids  []string
+		seen = make(map[string]bool)
+	)
+
+	for _, comp := range comps {
+		if types.Identical(comp.typ, typ) && !seen[comp.id] {
+			seen[comp.id] = true
+			ids = append(ids, quote(comp.id))
+		}
+	}
+
+	if len(ids) == 0 {
+		return ""
+	}
+
+	return ", connected ids: " + strings.Join(ids, ", ")
+}
+
+func quote(s string) string {
+	return strconv.Quote(s)
+}
@@ -0,0 +1,59 @@
+// Command wirecheck loads a program and builds an approximate dependency graph from components connected using
+// statically known values and ids, reporting duplicate components, missing dependencies and ambiguous interface
+// bindings before the program runs:
+//
+//	wirecheck ./...
+//
+// Components connected using a value or id only known at runtime are skipped,
+// and missing dependencies are not reported for container which components can't be fully determined.
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"io"
+	"os"
+
+	"golang.org/x/tools/go/packages"
+)
+
+func main() {
+	os.Exit(run(".", os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(dir string, patterns []string, stdout io.Writer, stderr io.Writer) int {
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedImports | packages.NeedDeps |
+			packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo,
+		Dir:  dir,
+		Fset: token.NewFileSet(),
+	}
+
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		fmt.Fprintln(stderr, "wirecheck:", err)
+		return 2
+	}
+
+	if packages.PrintErrors(pkgs) > 0 {
+		return 2
+	}
+
+	prog := newProgram(cfg.Fset, dir)
+	prog.add(pkgs)
+
+	diags := prog.check()
+	for _, d := range diags {
+		fmt.Fprintln(stdout, prog.position(d.pos)+": "+d.message)
+	}
+
+	if len(diags) != 0 {
+		return 1
+	}
+
+	return 0
+}
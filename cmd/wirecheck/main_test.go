@@ -0,0 +1,39 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	assert.Equal(t, 1, run("testdata/app", nil, &stdout, &stderr))
+	assert.Equal(t, ""+
+		"main.go:33:30: missing dependency for field Finder of example.com/app.Handler \"\" in global container, requires example.com/app/repo.Finder \"primary\"\n"+
+		"main.go:34:15: missing dependency for field Orders of example.com/app.Service \"\" in global container, requires example.com/app/repo.Repository \"order\", connected ids: \"users\"\n"+
+		"main.go:34:15: ambiguous dependency for field Finder of example.com/app.Service \"\" in global container, example.com/app/repo.Finder is implemented by example.com/app/repo.Repository \"users\", example.com/app.Cache \"users\"\n"+
+		"main.go:40:14: missing dependency for field Orders of example.com/app.Service \"\" in app, requires example.com/app/repo.Repository \"order\", connected ids: \"users\", \"orders\"\n"+
+		"main.go:40:14: missing dependency for field Cache of example.com/app.Service \"\" in app, requires example.com/app/repo.Finder \"\", connected ids: \"primary\"\n"+
+		"main.go:41:14: duplicate component example.com/app.Service \"\" connected to app, previously connected at main.go:40:14\n"+
+		"repo/repo.go:25:17: duplicate component example.com/app/repo.Repository \"orders\" connected to Module, previously connected at repo/repo.go:24:17\n"+
+		"report.go:14:15: ambiguous dependency for field Finder of example.com/app.Report \"\" in global container, example.com/app/repo.Finder is implemented by example.com/app/repo.Repository \"users\", example.com/app.Cache \"users\"\n",
+		stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
+func TestRun_clean(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	assert.Equal(t, 0, run("testdata/app", []string{"./clean"}, &stdout, &stderr))
+	assert.Equal(t, "", stdout.String())
+}
+
+func TestRun_loadError(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	assert.Equal(t, 2, run("testdata/missing", nil, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "wirecheck:")
+}
@@ -0,0 +1,429 @@
+package main
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/Fs02/wire/internal/wiretag"
+	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const wirePath = "github.com/Fs02/wire"
+
+// program is an approximate dependency graph built from connect calls found in the source.
+type program struct {
+	fset       *token.FileSet
+	dir        string
+	containers map[types.Object]*container
+	order      []types.Object
+	// views maps variable holding a profile view to the container it's created from.
+	views map[types.Object]types.Object
+}
+
+// container collects components connected to a container or module variable, global container uses nil key.
+type container struct {
+	name       string
+	module     bool
+	components []*component
+	includes   []types.Object
+	// created reports whether the variable is initialized by New or NewModule in the analyzed source.
+	created bool
+	// incomplete reports whether some components can't be determined statically,
+	// for example connected using variable id or passed to other function.
+	incomplete bool
+}
+
+type component struct {
+	typ         types.Type
+	id          string
+	dynamicID   bool
+	pos         token.Pos
+	conditional bool
+	replace     bool
+	alias       bool
+	deps        []dependency
+}
+
+type dependency struct {
+	field      string
+	typ        types.Type
+	id         string
+	impl       string
+	hasDefault bool
+	pos        token.Pos
+}
+
+func (c *component) String() string {
+	return types.TypeString(c.typ, nil) + " " + quote(c.id)
+}
+
+func newProgram(fset *token.FileSet, dir string) *program {
+	prog := &program{
+		fset:       fset,
+		dir:        dir,
+		containers: make(map[types.Object]*container),
+		views:      make(map[types.Object]types.Object),
+	}
+
+	prog.container(nil).created = true
+	return prog
+}
+
+// position describes pos with file name relative to the analyzed directory when it's inside it.
+func (prog *program) position(pos token.Pos) string {
+	position := prog.fset.Position(pos)
+	if abs, err := filepath.Abs(prog.dir); err == nil {
+		if rel, err := filepath.Rel(abs, position.Filename); err == nil && !strings.HasPrefix(rel, "..") {
+			position.Filename = rel
+		}
+	}
+
+	return position.String()
+}
+
+func (prog *program) container(key types.Object) *container {
+	if c, ok := prog.containers[key]; ok {
+		return c
+	}
+
+	c := &container{name: "global container"}
+	if key != nil {
+		c.name = key.Name()
+		c.module = isWireType(key.Type(), "Module")
+	}
+
+	prog.containers[key] = c
+	prog.order = append(prog.order, key)
+	return c
+}
+
+// add collects connect calls of every package importing wire.
+func (prog *program) add(pkgs []*packages.Package) {
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		if _, ok := pkg.Imports[wirePath]; !ok || pkg.TypesInfo == nil {
+			return
+		}
+
+		for _, file := range pkg.Syntax {
+			ast.Inspect(file, func(n ast.Node) bool {
+				switch n := n.(type) {
+				case *ast.CallExpr:
+					prog.call(pkg.TypesInfo, n)
+				case *ast.AssignStmt:
+					for i, rhs := range n.Rhs {
+						if i < len(n.Lhs) {
+							prog.assign(pkg.TypesInfo, n.Lhs[i], rhs)
+						}
+					}
+				case *ast.ValueSpec:
+					for i, rhs := range n.Values {
+						if i < len(n.Names) {
+							prog.assign(pkg.TypesInfo, n.Names[i], rhs)
+						}
+					}
+				case *ast.ReturnStmt:
+					for _, res := range n.Results {
+						prog.escape(pkg.TypesInfo, res)
+					}
+				}
+
+				return true
+			})
+		}
+	})
+}
+
+// assign marks container variable initialized by New or NewModule, and tracks variable holding a profile view.
+func (prog *program) assign(info *types.Info, lhs ast.Expr, rhs ast.Expr) {
+	call, ok := ast.Unparen(rhs).(*ast.CallExpr)
+	if !ok {
+		prog.escape(info, rhs)
+		return
+	}
+
+	fn := wireFunc(info, call)
+	if fn == nil {
+		return
+	}
+
+	key, _, ok := containerKey(info, lhs)
+	if !ok || key == nil {
+		return
+	}
+
+	if fn.Name() == "Profile" {
+		if parent, _, ok := prog.containerKey(info, call); ok {
+			prog.views[key] = parent
+		}
+
+		return
+	}
+
+	if (fn.Name() != "New" && fn.Name() != "NewModule") || fn.Type().(*types.Signature).Recv() != nil {
+		return
+	}
+
+	c := prog.container(key)
+	c.created = true
+
+	if fn.Name() == "NewModule" {
+		for _, arg := range call.Args[1:] {
+			if include, _, ok := containerKey(info, arg); ok {
+				c.includes = append(c.includes, include)
+			} else {
+				c.incomplete = true
+			}
+		}
+	}
+}
+
+// escape marks container referenced by expr as incomplete, since components might be connected elsewhere.
+func (prog *program) escape(info *types.Info, expr ast.Expr) {
+	expr = ast.Unparen(expr)
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		expr = ast.Unparen(unary.X)
+	}
+
+	switch expr.(type) {
+	case *ast.Ident, *ast.SelectorExpr:
+	default:
+		return
+	}
+
+	typ := info.TypeOf(expr)
+	if typ == nil || !(isWireType(typ, "Container") || isWireType(typ, "Module")) {
+		return
+	}
+
+	if key, _, ok := prog.containerKey(info, expr); ok && key != nil {
+		prog.container(key).incomplete = true
+	}
+}
+
+func (prog *program) call(info *types.Info, call *ast.CallExpr) {
+	fn := wireFunc(info, call)
+	if fn == nil {
+		for _, arg := range call.Args {
+			prog.escape(info, arg)
+		}
+
+		return
+	}
+
+	key, conditional, ok := prog.callContainer(info, call, fn)
+	if !ok {
+		return
+	}
+
+	switch fn.Name() {
+	case "Connect", "Replace":
+		prog.connect(info, key, call.Args, conditional, fn.Name() == "Replace")
+	case "ConnectIf":
+		if len(call.Args) > 1 {
+			prog.connect(info, key, call.Args[1:], true, false)
+		}
+	case "Alias", "AliasAs":
+		prog.alias(info, key, call.Args, conditional)
+	case "Install", "Include", "Merge":
+		for _, arg := range call.Args {
+			if include, _, ok := containerKey(info, arg); ok {
+				c := prog.container(key)
+				c.includes = append(c.includes, include)
+			} else {
+				prog.container(key).incomplete = true
+			}
+		}
+	}
+}
+
+// connect collects component connected using val and optional id.
+func (prog *program) connect(info *types.Info, key types.Object, args []ast.Expr, conditional bool, replace bool) {
+	c := prog.container(key)
+	if len(args) == 0 {
+		return
+	}
+
+	typ := info.TypeOf(args[0])
+	if typ == nil || types.IsInterface(typ) {
+		c.incomplete = true
+		return
+	}
+
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+
+	comp := &component{
+		typ:         typ,
+		pos:         args[0].Pos(),
+		conditional: conditional,
+		replace:     replace,
+	}
+
+	if len(args) > 1 {
+		comp.id, comp.dynamicID = stringValue(info, args[1])
+	}
+
+	// config struct is bound from config sources, it's type is only known by the type argument.
+	if isWireType(typ, "ConfigStruct") {
+		return
+	}
+
+	if st, ok := typ.Underlying().(*types.Struct); ok {
+		comp.deps = dependencies(st)
+	}
+
+	c.components = append(c.components, comp)
+}
+
+// alias collects component exposed using Alias or AliasAs.
+func (prog *program) alias(info *types.Info, key types.Object, args []ast.Expr, conditional bool) {
+	c := prog.container(key)
+	if len(args) < 3 {
+		return
+	}
+
+	typ := info.TypeOf(args[0])
+	if len(args) == 4 {
+		typ = info.TypeOf(args[2])
+	}
+
+	if typ == nil {
+		c.incomplete = true
+		return
+	}
+
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+
+	comp := &component{
+		typ:         typ,
+		pos:         args[0].Pos(),
+		conditional: conditional,
+		alias:       true,
+	}
+
+	comp.id, comp.dynamicID = stringValue(info, args[len(args)-1])
+	c.components = append(c.components, comp)
+}
+
+// dependencies collects fields wired from other components, fields bound from config are skipped.
+func dependencies(st *types.Struct) []dependency {
+	var deps []dependency
+
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		tval, ok := reflect.StructTag(st.Tag(i)).Lookup("wire")
+		if !field.Exported() || !ok || tval == "-" {
+			continue
+		}
+
+		tag := wiretag.Parse(tval)
+		if tag.HasConfig {
+			continue
+		}
+
+		dep := dependency{field: field.Name(), typ: field.Type(), id: tag.ID(), impl: tag.Impl(), hasDefault: tag.HasDefault, pos: field.Pos()}
+		if ptr, ok := dep.typ.Underlying().(*types.Pointer); ok {
+			dep.typ = ptr.Elem()
+		}
+
+		deps = append(deps, dep)
+	}
+
+	return deps
+}
+
+// callContainer finds the container a wire method call operates on,
+// method called on a profile view variable operates on the container the view is created from.
+func (prog *program) callContainer(info *types.Info, call *ast.CallExpr, fn *types.Func) (types.Object, bool, bool) {
+	key, conditional, ok := callContainer(info, call, fn)
+	if parent, view := prog.views[key]; ok && view {
+		return parent, true, true
+	}
+
+	return key, conditional, ok
+}
+
+// containerKey finds variable of container or module referenced by expr, resolving profile view variable to it's container.
+func (prog *program) containerKey(info *types.Info, expr ast.Expr) (types.Object, bool, bool) {
+	key, conditional, ok := containerKey(info, expr)
+	if parent, view := prog.views[key]; ok && view {
+		return parent, true, true
+	}
+
+	return key, conditional, ok
+}
+
+// wireFunc returns function or method of wire package called by call.
+func wireFunc(info *types.Info, call *ast.CallExpr) *types.Func {
+	fn, ok := typeutil.Callee(info, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != wirePath {
+		return nil
+	}
+
+	return fn
+}
+
+// callContainer finds the container a wire function or method call operates on.
+func callContainer(info *types.Info, call *ast.CallExpr, fn *types.Func) (types.Object, bool, bool) {
+	if fn.Type().(*types.Signature).Recv() == nil {
+		return nil, false, true
+	}
+
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return nil, false, false
+	}
+
+	return containerKey(info, sel.X)
+}
+
+// containerKey finds variable of container or module referenced by expr,
+// conditional reports whether it's a profile view of the container.
+func containerKey(info *types.Info, expr ast.Expr) (types.Object, bool, bool) {
+	switch expr := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		obj := info.ObjectOf(expr)
+		return obj, false, obj != nil
+	case *ast.SelectorExpr:
+		obj := info.ObjectOf(expr.Sel)
+		return obj, false, obj != nil
+	case *ast.UnaryExpr:
+		return containerKey(info, expr.X)
+	case *ast.CallExpr:
+		// profile view shares components of the container it's created from.
+		if fn := wireFunc(info, expr); fn != nil && fn.Name() == "Profile" {
+			key, _, ok := callContainer(info, expr, fn)
+			return key, true, ok
+		}
+	}
+
+	return nil, false, false
+}
+
+// stringValue returns constant value of expr, dynamic reports whether it's only known at runtime.
+func stringValue(info *types.Info, expr ast.Expr) (value string, dynamic bool) {
+	tv, ok := info.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", true
+	}
+
+	return constant.StringVal(tv.Value), false
+}
+
+func isWireType(typ types.Type, name string) bool {
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+
+	named, ok := typ.(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == wirePath && named.Obj().Name() == name
+}
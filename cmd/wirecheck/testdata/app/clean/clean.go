@@ -0,0 +1,49 @@
+package clean
+
+import "github.com/Fs02/wire"
+
+type Printer interface {
+	Print(string)
+}
+
+type Console struct{}
+
+func (c *Console) Print(s string) {}
+
+type Greeter struct {
+	Printer Printer `wire:""`
+	Name    string  `wire:"config=name"`
+}
+
+func Run() {
+	app := wire.New()
+	app.Connect(&Console{})
+	app.Connect(&Greeter{})
+	app.Apply()
+}
+
+type Store struct{}
+
+type Cache struct {
+	Store *Store `wire:""`
+}
+
+func RunProfile() {
+	app := wire.New()
+	dev := app.Profile("dev")
+	dev.Connect(&Store{})
+	app.Connect(&Cache{})
+	app.Apply()
+}
+
+type Labeler struct {
+	Printer Printer `wire:""`
+}
+
+func RunAlias() {
+	app := wire.New()
+	app.Connect(&Console{})
+	app.AliasAs((*Console)(nil), "", (*Printer)(nil), "console")
+	app.Connect(&Labeler{})
+	app.Apply()
+}
@@ -0,0 +1,7 @@
+module example.com/app
+
+go 1.22
+
+require github.com/Fs02/wire v0.0.0
+
+replace github.com/Fs02/wire => ../wire
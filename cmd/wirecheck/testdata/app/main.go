@@ -0,0 +1,55 @@
+package main
+
+import (
+	"os"
+
+	"example.com/app/repo"
+	"github.com/Fs02/wire"
+)
+
+type Cache struct{}
+
+func (c Cache) Find() string {
+	return ""
+}
+
+type Service struct {
+	Users  *repo.Repository `wire:"users"`
+	Orders *repo.Repository `wire:"order"`
+	Finder repo.Finder      `wire:"users"`
+	Cache  repo.Finder      `wire:",Cache"`
+	Limit  int              `wire:",default=10"`
+}
+
+type Handler struct {
+	Service *Service    `wire:""`
+	Finder  repo.Finder `wire:"primary"`
+}
+
+func init() {
+	wire.Connect(&Service{})
+	wire.Connect(Cache{}, "users")
+	wire.Connect(Cache{})
+	wire.Profile("dev").Connect(&Handler{})
+	wire.Replace(&Service{})
+}
+
+func main() {
+	app := wire.New()
+	app.Install(repo.Module)
+	app.Connect(&Service{})
+	app.Connect(&Service{})
+	app.AliasAs(Cache{}, "", (*repo.Finder)(nil), "primary")
+	app.Connect(&Handler{})
+	app.ConnectIf(len(os.Args) > 1, &repo.Repository{}, "users")
+
+	other := wire.New()
+	other.Connect(&Handler{}, os.Getenv("ID"))
+	register(other)
+
+	wire.Apply()
+}
+
+func register(container wire.Container) {
+	container.Connect(&Service{})
+}
@@ -0,0 +1,26 @@
+package repo
+
+import "github.com/Fs02/wire"
+
+type Repository struct {
+	Name string
+}
+
+func (r *Repository) Find() string {
+	return r.Name
+}
+
+type Finder interface {
+	Find() string
+}
+
+func init() {
+	wire.Connect(&Repository{}, "users")
+}
+
+var Module = wire.NewModule("repo")
+
+func init() {
+	Module.Connect(&Repository{}, "orders")
+	Module.Connect(&Repository{}, "orders")
+}
@@ -0,0 +1,15 @@
+package main
+
+import (
+	"example.com/app/repo"
+	"github.com/Fs02/wire"
+)
+
+type Report struct {
+	Finder repo.Finder `wire:"users,default="`
+	Backup repo.Finder `wire:"backup,default="`
+}
+
+func init() {
+	wire.Connect(&Report{})
+}
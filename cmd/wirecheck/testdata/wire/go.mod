@@ -0,0 +1,3 @@
+module github.com/Fs02/wire
+
+go 1.22
@@ -0,0 +1,32 @@
+// Package wire is a stub of wire api used to test wirecheck.
+package wire
+
+type Container struct{}
+
+func New() Container { return Container{} }
+
+func (container Container) Connect(val interface{}, id ...string)                               {}
+func (container Container) ConnectIf(cond bool, val interface{}, id ...string)                  {}
+func (container Container) Replace(val interface{}, id ...string)                               {}
+func (container Container) Profile(profiles ...string) Container                                { return container }
+func (container Container) Install(mod *Module)                                                 {}
+func (container Container) Merge(other Container)                                               {}
+func (container Container) Alias(val interface{}, id string, alias string)                      {}
+func (container Container) AliasAs(val interface{}, id string, iface interface{}, alias string) {}
+func (container Container) Apply()                                                              {}
+
+type Module struct{}
+
+func NewModule(name string, includes ...*Module) *Module { return &Module{} }
+
+func (mod *Module) Connect(val interface{}, id ...string) {}
+func (mod *Module) Include(mods ...*Module)               {}
+
+func Connect(val interface{}, id ...string)                               {}
+func ConnectIf(cond bool, val interface{}, id ...string)                  {}
+func Replace(val interface{}, id ...string)                               {}
+func Profile(profiles ...string) Container                                { return Container{} }
+func Install(mod *Module)                                                 {}
+func Alias(val interface{}, id string, alias string)                      {}
+func AliasAs(val interface{}, id string, iface interface{}, alias string) {}
+func Apply()                                                              {}
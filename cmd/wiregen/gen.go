@@ -0,0 +1,200 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"go/format"
+	"strconv"
+
+	"github.com/Fs02/wire"
+	"github.com/Fs02/wire/internal/codegen"
+)
+
+// options of the generated code.
+type options struct {
+	// source is the graph file name mentioned in the generated header.
+	source string
+	// pkg is the package name of the generated file.
+	pkg string
+	// importPath of the generated package, types declared in it are referenced without qualifier.
+	importPath string
+	// typeName of the generated struct holding the components.
+	typeName string
+}
+
+// holder is a field of the generated struct holding a component.
+type holder struct {
+	name    string
+	typ     string
+	pointer bool
+	comment string
+}
+
+type generator struct {
+	opts    options
+	imports *codegen.Imports
+	names   map[string]bool
+	holders map[*wire.GraphComponent]*holder
+}
+
+// generate go source assigning dependencies of active components in graph the same way wire does.
+func generate(graph wire.Graph, opts options) ([]byte, error) {
+	gen := &generator{
+		opts:    opts,
+		imports: codegen.NewImports(opts.pkg, opts.importPath),
+		names:   make(map[string]bool),
+		holders: make(map[*wire.GraphComponent]*holder),
+	}
+
+	var (
+		order []*holder
+		body  bytes.Buffer
+	)
+
+	for i := range graph.Components {
+		c := &graph.Components[i]
+		if !c.Active || c.AliasOf != "" {
+			continue
+		}
+
+		typ, err := gen.imports.Qualify(c.Type, c.Package)
+		if err != nil {
+			return nil, err
+		}
+
+		h := &holder{name: gen.name(*c), typ: typ, pointer: c.Pointer}
+		if c.Config {
+			h.comment = " // bound from config prefix " + strconv.Quote(c.ConfigPrefix)
+		}
+
+		gen.holders[c] = h
+		order = append(order, h)
+	}
+
+	// components are wired depth first like Apply, so value copies include their own dependencies.
+	visited := make(map[*wire.GraphComponent]bool)
+	var visit func(c *wire.GraphComponent) error
+	visit = func(c *wire.GraphComponent) error {
+		h, ok := gen.holders[c]
+		if !ok || visited[c] {
+			return nil
+		}
+
+		visited[c] = true
+
+		for _, dep := range c.Dependencies {
+			if dc := gen.original(graph, dep.Component); dc != nil {
+				if err := visit(dc); err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, dep := range c.Dependencies {
+			field := "c." + h.name + "." + dep.Field
+
+			switch {
+			case len(dep.Decorators) != 0:
+				return errors.New("field " + dep.Field + " of " + c.Key + " is decorated, decorators are not supported")
+			case dep.Error != "":
+				return errors.New("field " + dep.Field + " of " + c.Key + " is not resolved: " + dep.Error)
+			case dep.Config != "":
+				return errors.New("field " + dep.Field + " of " + c.Key + " is bound from config, config values are not supported")
+			case dep.Component == "" && dep.Default != nil:
+				return errors.New("field " + dep.Field + " of " + c.Key + " uses default value, default values are not supported")
+			default:
+				value, err := gen.value(graph, dep)
+				if err != nil {
+					return err
+				}
+
+				body.WriteString("\t" + field + " = " + value + "\n")
+			}
+		}
+
+		return nil
+	}
+
+	for i := range graph.Components {
+		if err := visit(&graph.Components[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by wiregen")
+	if opts.source != "" {
+		b.WriteString(" from " + opts.source)
+	}
+
+	b.WriteString(". DO NOT EDIT.\n\npackage " + opts.pkg + "\n\n")
+
+	gen.imports.Write(&b)
+
+	b.WriteString("// " + opts.typeName + " holds components wired by Wire.\n")
+	b.WriteString("type " + opts.typeName + " struct {\n")
+	for _, h := range order {
+		ptr := ""
+		if h.pointer {
+			ptr = "*"
+		}
+
+		b.WriteString("\t" + h.name + " " + ptr + h.typ + h.comment + "\n")
+	}
+
+	b.WriteString("}\n\n")
+	b.WriteString("// Wire assigns dependencies of components the same way wire.Container.Apply does.\n")
+	b.WriteString("func (c *" + opts.typeName + ") Wire() {\n")
+	b.Write(body.Bytes())
+	b.WriteString("}\n")
+
+	return format.Source(b.Bytes())
+}
+
+// value returns expression of the component wired into dep.
+func (gen *generator) value(graph wire.Graph, dep wire.GraphDependency) (string, error) {
+	h, ok := gen.holders[gen.original(graph, dep.Component)]
+	if !ok {
+		return "", errors.New("component " + dep.Component + " is not found in the graph")
+	}
+
+	switch {
+	case h.pointer && !dep.Pointer:
+		return "*c." + h.name, nil
+	case !h.pointer && dep.Pointer:
+		return "&c." + h.name, nil
+	}
+
+	return "c." + h.name, nil
+}
+
+// original returns the component identified by key, or the component it's aliasing.
+func (gen *generator) original(graph wire.Graph, key string) *wire.GraphComponent {
+	for i := range graph.Components {
+		if c := &graph.Components[i]; key != "" && c.Key == key {
+			if c.AliasOf != "" {
+				return gen.original(graph, c.AliasOf)
+			}
+
+			return c
+		}
+	}
+
+	return nil
+}
+
+// name returns unique field name of component, derived from it's type and id.
+func (gen *generator) name(c wire.GraphComponent) string {
+	base := codegen.Exported(codegen.TypeName(c.Type)) + codegen.Exported(c.ID)
+	if base == "" {
+		base = "Component"
+	}
+
+	name := base
+	for n := 2; gen.names[name]; n++ {
+		name = base + strconv.Itoa(n)
+	}
+
+	gen.names[name] = true
+	return name
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/Fs02/wire"
+	"github.com/Fs02/wire/cmd/wiregen/testdata/app/components"
+	"github.com/stretchr/testify/assert"
+)
+
+type Printer interface {
+	Print(string)
+}
+
+type Console struct{}
+
+func (c *Console) Print(s string) {}
+
+type Settings struct {
+	Name string
+}
+
+// Archive sorts before Repository, but it's wired after Repository since it's holding a copy.
+type Archive struct {
+	Repository Repository `wire:"orders"`
+}
+
+type Repository struct {
+	Buffer *bytes.Buffer `wire:""`
+}
+
+type Service struct {
+	Printer    Printer     `wire:""`
+	Users      *Repository `wire:"primary"`
+	Orders     Repository  `wire:"orders"`
+	Settings   *Settings   `wire:""`
+	Greeting   string      `wire:"greeting"`
+	Unexported string
+}
+
+type Limiter struct {
+	Limit int `wire:",default=10"`
+}
+
+type Client struct {
+	Timeout string `wire:"config=timeout,default=1s"`
+}
+
+func graph() wire.Graph {
+	app := wire.New()
+	app.Connect(&Console{})
+	app.Connect(&bytes.Buffer{})
+	app.Connect(&Repository{}, "users")
+	app.Connect(&Repository{}, "orders")
+	app.Alias((*Repository)(nil), "users", "primary")
+	app.Connect(wire.Config[Settings]("app"))
+	app.Connect("Hello", "greeting")
+	app.Profile("dev").Connect(&Console{}, "dev")
+	app.Connect(&Service{})
+	app.Connect(&Archive{})
+	return app.Graph()
+}
+
+func TestGenerate(t *testing.T) {
+	src, err := generate(graph(), options{source: "wire.json", pkg: "main", importPath: "github.com/Fs02/wire/cmd/wiregen", typeName: "Components"})
+	assert.Nil(t, err)
+	assert.Equal(t, `// Code generated by wiregen from wire.json. DO NOT EDIT.
+
+package main
+
+import (
+	"bytes"
+)
+
+// Components holds components wired by Wire.
+type Components struct {
+	Buffer           *bytes.Buffer
+	Archive          *Archive
+	Console          *Console
+	RepositoryOrders *Repository
+	RepositoryUsers  *Repository
+	Service          *Service
+	Settings         *Settings // bound from config prefix "app"
+	StringGreeting   string
+}
+
+// Wire assigns dependencies of components the same way wire.Container.Apply does.
+func (c *Components) Wire() {
+	c.RepositoryOrders.Buffer = c.Buffer
+	c.Archive.Repository = *c.RepositoryOrders
+	c.RepositoryUsers.Buffer = c.Buffer
+	c.Service.Printer = c.Console
+	c.Service.Users = c.RepositoryUsers
+	c.Service.Orders = *c.RepositoryOrders
+	c.Service.Settings = c.Settings
+	c.Service.Greeting = c.StringGreeting
+}
+`, string(src))
+}
+
+func TestGenerate_build(t *testing.T) {
+	app := wire.New()
+	components.Connect(app)
+
+	src, err := generate(app.Graph(), options{source: "wire.json", pkg: "main", importPath: "github.com/Fs02/wire/cmd/wiregen/testdata/app", typeName: "Components"})
+	assert.Nil(t, err)
+
+	var (
+		dir     = t.TempDir()
+		gen     = filepath.Join(dir, "wire_gen.go")
+		overlay = filepath.Join(dir, "overlay.json")
+	)
+
+	target, err := filepath.Abs(filepath.Join("testdata", "app", "wire_gen.go"))
+	assert.Nil(t, err)
+
+	data, err := json.Marshal(map[string]map[string]string{"Replace": {target: gen}})
+	assert.Nil(t, err)
+	assert.Nil(t, os.WriteFile(gen, src, 0644))
+	assert.Nil(t, os.WriteFile(overlay, data, 0644))
+
+	out, err := exec.Command("go", "run", "-overlay", overlay, "./testdata/app").CombinedOutput()
+	assert.Nil(t, err, string(out))
+	assert.Equal(t, "", string(out))
+}
+
+func TestGenerate_unsupported(t *testing.T) {
+	app := wire.New()
+	app.Connect(&Console{})
+	app.Connect(&Service{})
+	app.Decorate(func(p Printer) Printer { return p })
+
+	_, err := generate(app.Graph(), options{pkg: "main", importPath: "github.com/Fs02/wire/cmd/wiregen", typeName: "Components"})
+	assert.EqualError(t, err, "field Printer of main.Service \"\" is decorated, decorators are not supported")
+
+	app = wire.New()
+	app.Connect(&Limiter{})
+	_, err = generate(app.Graph(), options{pkg: "main", importPath: "github.com/Fs02/wire/cmd/wiregen", typeName: "Components"})
+	assert.EqualError(t, err, "field Limit of main.Limiter \"\" uses default value, default values are not supported")
+
+	app = wire.New()
+	app.Connect(&Client{})
+	_, err = generate(app.Graph(), options{pkg: "main", importPath: "github.com/Fs02/wire/cmd/wiregen", typeName: "Components"})
+	assert.EqualError(t, err, "field Timeout of main.Client \"\" is bound from config, config values are not supported")
+
+	_, err = generate(wire.New().Graph(), options{pkg: "other", typeName: "Components"})
+	assert.Nil(t, err)
+
+	app = wire.New()
+	app.Connect(&Console{})
+	_, err = generate(app.Graph(), options{pkg: "other", typeName: "Components"})
+	assert.Nil(t, err)
+}
@@ -0,0 +1,92 @@
+// Command wiregen generates plain go code assigning dependencies of components,
+// from the graph recorded using wire.Container.RecordGraph:
+//
+//	app.RecordGraph("wire.json")
+//	wiregen -package main -o wire_gen.go wire.json
+//
+// The generated struct holds every active component, which are created by the caller instead of connected,
+// and it's Wire method does the same field assignments as Apply without reflection.
+// Components with fields that are decorated, bound from config or using default value are rejected,
+// since Wire can't assign them the same way Apply does.
+// A test using wiretest.AssertGraph confirms that the container still resolves the recorded graph,
+// so the generated code doesn't silently go out of date.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/Fs02/wire"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	var (
+		flags = flag.NewFlagSet("wiregen", flag.ContinueOnError)
+		opts  options
+		out   string
+	)
+
+	flags.SetOutput(stderr)
+	flags.StringVar(&opts.pkg, "package", "main", "package name of the generated file")
+	flags.StringVar(&opts.importPath, "importpath", "", "import path of the generated package, defaults to main for main package")
+	flags.StringVar(&opts.typeName, "type", "Components", "name of the generated struct")
+	flags.StringVar(&out, "o", "", "output file, defaults to standard output")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: wiregen [flags] graph.json")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	if opts.importPath == "" && opts.pkg == "main" {
+		opts.importPath = "main"
+	}
+
+	opts.source = filepath.Base(flags.Arg(0))
+
+	f, err := os.Open(flags.Arg(0))
+	if err != nil {
+		fmt.Fprintln(stderr, "wiregen:", err)
+		return 1
+	}
+	defer f.Close()
+
+	graph, err := wire.ReadGraph(f)
+	if err != nil {
+		fmt.Fprintln(stderr, "wiregen:", err)
+		return 1
+	}
+
+	src, err := generate(graph, opts)
+	if err != nil {
+		fmt.Fprintln(stderr, "wiregen:", err)
+		return 1
+	}
+
+	if out == "" {
+		_, err = stdout.Write(src)
+	} else {
+		err = os.WriteFile(out, src, 0644)
+	}
+
+	if err != nil {
+		fmt.Fprintln(stderr, "wiregen:", err)
+		return 1
+	}
+
+	return 0
+}
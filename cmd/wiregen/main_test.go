@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	var (
+		dir    = t.TempDir()
+		input  = filepath.Join(dir, "wire.json")
+		output = filepath.Join(dir, "wire_gen.go")
+		stdout bytes.Buffer
+		stderr bytes.Buffer
+	)
+
+	data, err := json.Marshal(graph())
+	assert.Nil(t, err)
+	assert.Nil(t, os.WriteFile(input, data, 0644))
+
+	assert.Equal(t, 0, run([]string{"-importpath", "github.com/Fs02/wire/cmd/wiregen", "-type", "Wiring", "-o", output, input}, &stdout, &stderr))
+	assert.Equal(t, "", stderr.String())
+
+	src, err := os.ReadFile(output)
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "// Code generated by wiregen from wire.json. DO NOT EDIT.\n\npackage main\n")
+	assert.Contains(t, string(src), "func (c *Wiring) Wire() {\n")
+
+	assert.Equal(t, 0, run([]string{"-importpath", "github.com/Fs02/wire/cmd/wiregen", input}, &stdout, &stderr))
+	assert.Equal(t, string(src), string(bytes.Replace(stdout.Bytes(), []byte("Components"), []byte("Wiring"), -1)))
+}
+
+func TestRun_invalid(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	assert.Equal(t, 2, run(nil, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "usage: wiregen [flags] graph.json")
+
+	stderr.Reset()
+	assert.Equal(t, 2, run([]string{"-unknown"}, &stdout, &stderr))
+
+	stderr.Reset()
+	assert.Equal(t, 1, run([]string{filepath.Join(t.TempDir(), "missing.json")}, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "wiregen: open ")
+
+	stderr.Reset()
+	input := filepath.Join(t.TempDir(), "wire.json")
+	assert.Nil(t, os.WriteFile(input, []byte(`{"version": 1, "components": [{"key": "main.t \"\"", "type": "main.t", "package": "example.com/app", "active": true}]}`), 0644))
+	assert.Equal(t, 1, run([]string{input}, &stdout, &stderr))
+	assert.Equal(t, "wiregen: unexported type main.t can't be referenced from package main\n", stderr.String())
+}
@@ -0,0 +1,47 @@
+// Package components is connected by TestGenerate_build, and wired both by wire and the code generated for it.
+package components
+
+import (
+	"bytes"
+
+	"github.com/Fs02/wire"
+)
+
+type Printer interface {
+	Print(string)
+}
+
+type Console struct {
+	Name string
+}
+
+func (c *Console) Print(s string) {}
+
+type Repository struct {
+	Name   string
+	Buffer *bytes.Buffer `wire:""`
+}
+
+type Archive struct {
+	Repository Repository `wire:"orders"`
+}
+
+type Service struct {
+	Printer  Printer     `wire:""`
+	Users    *Repository `wire:"primary"`
+	Orders   Repository  `wire:"orders"`
+	Greeting string      `wire:"greeting"`
+}
+
+// Connect components to app.
+func Connect(app wire.Container) {
+	app.Connect(&Console{Name: "console"})
+	app.Profile("dev").Connect(&Console{Name: "dev"}, "dev")
+	app.Connect(bytes.NewBufferString("buffer"))
+	app.Connect(&Repository{Name: "users"}, "users")
+	app.Connect(&Repository{Name: "orders"}, "orders")
+	app.Alias((*Repository)(nil), "users", "primary")
+	app.Connect("Hello", "greeting")
+	app.Connect(&Service{})
+	app.Connect(&Archive{})
+}
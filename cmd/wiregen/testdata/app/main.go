@@ -0,0 +1,57 @@
+// Command app compares components wired by the code generated by wiregen with the components wired by wire,
+// wire_gen.go is generated by TestGenerate_build.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"reflect"
+
+	"github.com/Fs02/wire"
+	"github.com/Fs02/wire/cmd/wiregen/testdata/app/components"
+)
+
+func main() {
+	app := wire.New()
+	components.Connect(app)
+	app.Apply()
+
+	var expected Components
+	app.Resolve(&expected.Buffer)
+	app.Resolve(&expected.Archive)
+	app.Resolve(&expected.Console)
+	app.Resolve(&expected.RepositoryOrders, "orders")
+	app.Resolve(&expected.RepositoryUsers, "users")
+	app.Resolve(&expected.Service)
+	app.Resolve(&expected.StringGreeting, "greeting")
+
+	generated := Components{
+		Buffer:           bytes.NewBufferString("buffer"),
+		Archive:          &components.Archive{},
+		Console:          &components.Console{Name: "console"},
+		RepositoryOrders: &components.Repository{Name: "orders"},
+		RepositoryUsers:  &components.Repository{Name: "users"},
+		Service:          &components.Service{},
+		StringGreeting:   "Hello",
+	}
+	generated.Wire()
+
+	var (
+		failed bool
+		ev     = reflect.ValueOf(expected)
+		gv     = reflect.ValueOf(generated)
+	)
+
+	for i := 0; i < ev.NumField(); i++ {
+		e, g := reflect.Indirect(ev.Field(i)).Interface(), reflect.Indirect(gv.Field(i)).Interface()
+		if !reflect.DeepEqual(e, g) {
+			fmt.Printf("%s wired by wire: %+v, wired by generated code: %+v\n", ev.Type().Field(i).Name, e, g)
+			failed = true
+		}
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
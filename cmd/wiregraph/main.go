@@ -0,0 +1,67 @@
+// Command wiregraph works with dependency graphs exported using wire.Container.WriteJSON.
+//
+// Usage:
+//
+//	wiregraph diff old.json new.json
+//
+// diff reports added or removed components, changed bindings and fields that resolve to a different component.
+// It exits with status 1 when the graphs are different, so it can be used to check a committed golden file.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/Fs02/wire"
+	"github.com/Fs02/wire/internal/graphdiff"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	if len(args) != 3 || args[0] != "diff" {
+		fmt.Fprintln(stderr, "usage: wiregraph diff old.json new.json")
+		return 2
+	}
+
+	old, err := readGraph(args[1])
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	new, err := readGraph(args[2])
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	changes := graphdiff.Diff(old, new)
+	for _, change := range changes {
+		fmt.Fprintln(stdout, change)
+	}
+
+	if len(changes) != 0 {
+		return 1
+	}
+
+	return 0
+}
+
+func readGraph(path string) (wire.Graph, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return wire.Graph{}, err
+	}
+	defer f.Close()
+
+	graph, err := wire.ReadGraph(f)
+	if err != nil {
+		return graph, fmt.Errorf("%s: %v", path, err)
+	}
+
+	return graph, nil
+}
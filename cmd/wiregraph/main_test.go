@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Fs02/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeGraph(t *testing.T, path string, container wire.Container) {
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if err := container.WriteJSON(f); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRun(t *testing.T) {
+	var (
+		dir     = t.TempDir()
+		oldPath = filepath.Join(dir, "old.json")
+		newPath = filepath.Join(dir, "new.json")
+		app     = wire.New()
+	)
+
+	app.Connect("hello")
+	writeGraph(t, oldPath, app)
+	app.Connect("world", "world")
+	writeGraph(t, newPath, app)
+
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, 0, run([]string{"diff", oldPath, oldPath}, &stdout, &stderr))
+	assert.Equal(t, "", stdout.String())
+
+	assert.Equal(t, 1, run([]string{"diff", oldPath, newPath}, &stdout, &stderr))
+	assert.Equal(t, "+ component string \"world\" added\n", stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
+func TestRun_invalid(t *testing.T) {
+	var (
+		dir     = t.TempDir()
+		badPath = filepath.Join(dir, "bad.json")
+		stdout  bytes.Buffer
+		stderr  bytes.Buffer
+	)
+
+	assert.Nil(t, os.WriteFile(badPath, []byte(`{"version": 0}`), 0644))
+
+	assert.Equal(t, 2, run([]string{"diff", badPath}, &stdout, &stderr))
+	assert.Equal(t, "usage: wiregraph diff old.json new.json\n", stderr.String())
+
+	stderr.Reset()
+	assert.Equal(t, 2, run([]string{"diff", filepath.Join(dir, "missing.json"), badPath}, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "missing.json")
+
+	stderr.Reset()
+	assert.Equal(t, 2, run([]string{"diff", badPath, badPath}, &stdout, &stderr))
+	assert.Equal(t, badPath+": wire: unsupported graph version 0, expected version 1\n", stderr.String())
+}
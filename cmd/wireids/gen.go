@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"go/format"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Fs02/wire"
+	"github.com/Fs02/wire/internal/codegen"
+)
+
+// options of the generated code.
+type options struct {
+	// source is the file name mentioned in the generated header.
+	source string
+	// pkg is the package name of the generated file.
+	pkg string
+	// importPath of the generated package, types declared in it are referenced without qualifier.
+	importPath string
+}
+
+// entry is a component identified by id.
+type entry struct {
+	typ     string
+	pkgPath string
+	id      string
+	pointer bool
+}
+
+// graphEntries collects components connected using id from graph.
+func graphEntries(graph wire.Graph) []entry {
+	var entries []entry
+
+	for _, c := range graph.Components {
+		if c.ID == "" {
+			continue
+		}
+
+		e := entry{typ: c.Type, pkgPath: c.Package, id: c.ID, pointer: c.Pointer}
+
+		// component aliased as interface is resolved as the interface.
+		if original, ok := graph.Find(c.AliasOf); ok && original.Type != c.Type {
+			e.pointer = false
+		}
+
+		entries = append(entries, e)
+	}
+
+	return entries
+}
+
+// declaredEntries parses declaration file listing a component type and it's id per line, for example:
+//
+//	# pointer component
+//	*example.com/app.UserPrint foo
+//	string greeting
+func declaredEntries(r io.Reader) ([]entry, error) {
+	var (
+		entries []entry
+		scanner = bufio.NewScanner(r)
+		line    = 0
+	)
+
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return nil, errors.New("line " + strconv.Itoa(line) + ": expected type and id, got " + strconv.Quote(text))
+		}
+
+		e := entry{id: fields[1], typ: fields[0]}
+		if strings.HasPrefix(e.typ, "*") {
+			e.typ, e.pointer = e.typ[1:], true
+		}
+
+		if i := strings.LastIndex(e.typ, "."); i >= 0 {
+			e.pkgPath = e.typ[:i]
+			e.typ = path.Base(e.pkgPath) + e.typ[i:]
+		}
+
+		entries = append(entries, e)
+	}
+
+	return entries, scanner.Err()
+}
+
+// generate go source declaring id constants and accessors resolving each entry.
+func generate(entries []entry, opts options) ([]byte, error) {
+	var (
+		imports = codegen.NewImports(opts.pkg, opts.importPath)
+		names   = make(map[string]bool)
+		seen    = make(map[string]bool)
+		consts  bytes.Buffer
+		funcs   bytes.Buffer
+	)
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].id < entries[j].id
+	})
+
+	wirePkg := imports.Add("github.com/Fs02/wire", "wire")
+
+	for _, e := range entries {
+		key := e.pkgPath + "." + e.typ + " " + e.id
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		typ, err := imports.Qualify(e.typ, e.pkgPath)
+		if err != nil {
+			return nil, err
+		}
+
+		if e.pointer {
+			typ = "*" + typ
+		}
+
+		name := codegen.Exported(e.id) + codegen.Exported(codegen.TypeName(e.typ))
+		if names[name] {
+			// same id and type name from different packages.
+			name = codegen.Exported(e.id) + codegen.Exported(strings.Replace(e.typ, ".", " ", 1))
+		}
+
+		base := name
+		for n := 2; names[name]; n++ {
+			name = base + strconv.Itoa(n)
+		}
+
+		names[name] = true
+
+		consts.WriteString("\t// " + name + " identifies " + typ + " connected using " + strconv.Quote(e.id) + ".\n")
+		consts.WriteString("\t" + name + " = " + strconv.Quote(e.id) + "\n")
+
+		funcs.WriteString("// Must" + name + " resolves " + typ + " identified using " + name + " from container.\n")
+		funcs.WriteString("// It panics when the component is not found.\n")
+		funcs.WriteString("func Must" + name + "(container " + wirePkg + ".Container) " + typ + " {\n")
+		funcs.WriteString("\tvar v " + typ + "\n")
+		funcs.WriteString("\tcontainer.Resolve(&v, " + name + ")\n")
+		funcs.WriteString("\treturn v\n")
+		funcs.WriteString("}\n\n")
+	}
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by wireids")
+	if opts.source != "" {
+		b.WriteString(" from " + opts.source)
+	}
+
+	b.WriteString(". DO NOT EDIT.\n\npackage " + opts.pkg + "\n\n")
+	imports.Write(&b)
+
+	b.WriteString("// Ids of connected components.\n")
+	b.WriteString("const (\n")
+	b.Write(consts.Bytes())
+	b.WriteString(")\n\n")
+	b.Write(funcs.Bytes())
+
+	return format.Source(b.Bytes())
+}
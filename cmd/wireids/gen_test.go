@@ -0,0 +1,159 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/Fs02/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+type Printer interface {
+	Print() string
+}
+
+type UserPrint struct {
+	Name string
+}
+
+func (up UserPrint) Print() string {
+	return up.Name
+}
+
+func graph() wire.Graph {
+	app := wire.New()
+	app.Profile("prod").Connect(&UserPrint{Name: "foo"}, "foo")
+	app.Profile("dev").Connect(&UserPrint{Name: "dev"}, "foo")
+	app.AliasAs((*UserPrint)(nil), "foo", (*Printer)(nil), "printer")
+	app.Connect(UserPrint{}, "default")
+	app.Connect("Hello", "greeting")
+	app.Connect(&bytes.Buffer{}, "out")
+	app.Connect(&UserPrint{})
+	return app.Graph()
+}
+
+func TestGenerate(t *testing.T) {
+	src, err := generate(graphEntries(graph()), options{source: "wire.json", pkg: "wireids", importPath: "github.com/Fs02/wire/cmd/wireids"})
+	assert.Nil(t, err)
+	assert.Equal(t, `// Code generated by wireids from wire.json. DO NOT EDIT.
+
+package wireids
+
+import (
+	"bytes"
+
+	"github.com/Fs02/wire"
+)
+
+// Ids of connected components.
+const (
+	// DefaultUserPrint identifies UserPrint connected using "default".
+	DefaultUserPrint = "default"
+	// FooUserPrint identifies *UserPrint connected using "foo".
+	FooUserPrint = "foo"
+	// GreetingString identifies string connected using "greeting".
+	GreetingString = "greeting"
+	// OutBuffer identifies *bytes.Buffer connected using "out".
+	OutBuffer = "out"
+	// PrinterPrinter identifies Printer connected using "printer".
+	PrinterPrinter = "printer"
+)
+
+// MustDefaultUserPrint resolves UserPrint identified using DefaultUserPrint from container.
+// It panics when the component is not found.
+func MustDefaultUserPrint(container wire.Container) UserPrint {
+	var v UserPrint
+	container.Resolve(&v, DefaultUserPrint)
+	return v
+}
+
+// MustFooUserPrint resolves *UserPrint identified using FooUserPrint from container.
+// It panics when the component is not found.
+func MustFooUserPrint(container wire.Container) *UserPrint {
+	var v *UserPrint
+	container.Resolve(&v, FooUserPrint)
+	return v
+}
+
+// MustGreetingString resolves string identified using GreetingString from container.
+// It panics when the component is not found.
+func MustGreetingString(container wire.Container) string {
+	var v string
+	container.Resolve(&v, GreetingString)
+	return v
+}
+
+// MustOutBuffer resolves *bytes.Buffer identified using OutBuffer from container.
+// It panics when the component is not found.
+func MustOutBuffer(container wire.Container) *bytes.Buffer {
+	var v *bytes.Buffer
+	container.Resolve(&v, OutBuffer)
+	return v
+}
+
+// MustPrinterPrinter resolves Printer identified using PrinterPrinter from container.
+// It panics when the component is not found.
+func MustPrinterPrinter(container wire.Container) Printer {
+	var v Printer
+	container.Resolve(&v, PrinterPrinter)
+	return v
+}
+`, string(src))
+}
+
+func TestGenerate_resolve(t *testing.T) {
+	// accessors resolve the same way as generated code does.
+	app := wire.New()
+	app.Connect(&UserPrint{Name: "foo"}, "foo")
+	app.Connect(UserPrint{Name: "default"}, "default")
+	app.AliasAs((*UserPrint)(nil), "foo", (*Printer)(nil), "printer")
+	app.Apply()
+
+	for _, e := range graphEntries(app.Graph()) {
+		switch {
+		case e.id == "printer":
+			var v Printer
+			app.Resolve(&v, e.id)
+			assert.Equal(t, "foo", v.Print())
+			assert.False(t, e.pointer)
+		case e.pointer:
+			var v *UserPrint
+			app.Resolve(&v, e.id)
+			assert.Equal(t, e.id, v.Name)
+		default:
+			var v UserPrint
+			app.Resolve(&v, e.id)
+			assert.Equal(t, e.id, v.Name)
+		}
+	}
+}
+
+func TestGenerate_sameName(t *testing.T) {
+	src, err := generate([]entry{
+		{typ: "repo.Repository", pkgPath: "example.com/app/repo", id: "users", pointer: true},
+		{typ: "cache.Repository", pkgPath: "example.com/app/cache", id: "users"},
+		{typ: "cache.Repository", pkgPath: "example.com/app/cache", id: "users"},
+	}, options{pkg: "wireids"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "\tUsersRepository = \"users\"\n")
+	assert.Contains(t, string(src), "\tUsersCacheRepository = \"users\"\n")
+	assert.Equal(t, 2, strings.Count(string(src), "func Must"))
+}
+
+func TestDeclaredEntries(t *testing.T) {
+	entries, err := declaredEntries(strings.NewReader(`
+# pointer component
+*example.com/app.UserPrint foo
+
+string greeting
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, []entry{
+		{typ: "app.UserPrint", pkgPath: "example.com/app", id: "foo", pointer: true},
+		{typ: "string", id: "greeting"},
+	}, entries)
+
+	_, err = declaredEntries(strings.NewReader("string"))
+	assert.EqualError(t, err, "line 1: expected type and id, got \"string\"")
+}
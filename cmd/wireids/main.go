@@ -0,0 +1,108 @@
+// Command wireids generates typed id constants and accessors of components connected using id,
+// so ids used in wire tags and Resolve calls are checked by the compiler:
+//
+//	wireids -o wireids/wireids.go wire.json
+//	wireids -o wireids/wireids.go ids.txt
+//
+// Components are read from the graph recorded using wire.Container.RecordGraph,
+// or from a declaration file listing a component type and it's id per line:
+//
+//	# pointer component
+//	*example.com/app.UserPrint foo
+//	string greeting
+//
+// For each component, a constant such as FooUserPrint and an accessor such as MustFooUserPrint(container) are generated.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Fs02/wire"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	var (
+		flags = flag.NewFlagSet("wireids", flag.ContinueOnError)
+		opts  options
+		out   string
+	)
+
+	flags.SetOutput(stderr)
+	flags.StringVar(&opts.pkg, "package", "wireids", "package name of the generated file")
+	flags.StringVar(&opts.importPath, "importpath", "", "import path of the generated package")
+	flags.StringVar(&out, "o", "", "output file, defaults to standard output")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: wireids [flags] graph.json|ids.txt")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	opts.source = filepath.Base(flags.Arg(0))
+
+	entries, err := readEntries(flags.Arg(0))
+	if err != nil {
+		fmt.Fprintln(stderr, "wireids:", err)
+		return 1
+	}
+
+	src, err := generate(entries, opts)
+	if err != nil {
+		fmt.Fprintln(stderr, "wireids:", err)
+		return 1
+	}
+
+	if out == "" {
+		_, err = stdout.Write(src)
+	} else {
+		err = os.WriteFile(out, src, 0644)
+	}
+
+	if err != nil {
+		fmt.Fprintln(stderr, "wireids:", err)
+		return 1
+	}
+
+	return 0
+}
+
+// readEntries reads components from graph json or declaration file.
+func readEntries(path string) ([]entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if strings.HasSuffix(path, ".json") {
+		graph, err := wire.ReadGraph(f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+
+		return graphEntries(graph), nil
+	}
+
+	entries, err := declaredEntries(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	var (
+		dir    = t.TempDir()
+		graphs = filepath.Join(dir, "wire.json")
+		decls  = filepath.Join(dir, "ids.txt")
+		output = filepath.Join(dir, "wireids.go")
+		stdout bytes.Buffer
+		stderr bytes.Buffer
+	)
+
+	data, err := json.Marshal(graph())
+	assert.Nil(t, err)
+	assert.Nil(t, os.WriteFile(graphs, data, 0644))
+	assert.Nil(t, os.WriteFile(decls, []byte("*github.com/Fs02/wire/cmd/wireids.UserPrint foo\n"), 0644))
+
+	assert.Equal(t, 0, run([]string{"-package", "ids", "-o", output, graphs}, &stdout, &stderr))
+	assert.Equal(t, "", stderr.String())
+
+	src, err := os.ReadFile(output)
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "// Code generated by wireids from wire.json. DO NOT EDIT.\n\npackage ids\n")
+	assert.Contains(t, string(src), "func MustFooUserPrint(container wire.Container) *main.UserPrint {\n")
+
+	assert.Equal(t, 0, run([]string{decls}, &stdout, &stderr))
+	assert.Contains(t, stdout.String(), "// Code generated by wireids from ids.txt. DO NOT EDIT.\n\npackage wireids\n")
+	assert.Contains(t, stdout.String(), "func MustFooUserPrint(container wire.Container) *wireids.UserPrint {\n")
+}
+
+func TestRun_invalid(t *testing.T) {
+	var (
+		dir    = t.TempDir()
+		stdout bytes.Buffer
+		stderr bytes.Buffer
+	)
+
+	assert.Equal(t, 2, run(nil, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "usage: wireids [flags] graph.json|ids.txt")
+
+	stderr.Reset()
+	assert.Equal(t, 1, run([]string{filepath.Join(dir, "missing.json")}, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "wireids: open ")
+
+	stderr.Reset()
+	path := filepath.Join(dir, "wire.json")
+	assert.Nil(t, os.WriteFile(path, []byte(`{"version": 0}`), 0644))
+	assert.Equal(t, 1, run([]string{path}, &stdout, &stderr))
+	assert.Equal(t, "wireids: "+path+": wire: unsupported graph version 0, expected version 1\n", stderr.String())
+
+	stderr.Reset()
+	path = filepath.Join(dir, "ids.txt")
+	assert.Nil(t, os.WriteFile(path, []byte("example.com/app.user foo\n"), 0644))
+	assert.Equal(t, 1, run([]string{path}, &stdout, &stderr))
+	assert.Equal(t, "wireids: unexported type app.user can't be referenced from package wireids\n", stderr.String())
+}
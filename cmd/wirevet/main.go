@@ -0,0 +1,13 @@
+// Command wirevet checks wire tags and components connected using wire, it can be run directly or by go vet:
+//
+//	go vet -vettool=$(which wirevet) ./...
+package main
+
+import (
+	"github.com/Fs02/wire/wirevet"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(wirevet.Analyzer)
+}
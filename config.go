@@ -0,0 +1,361 @@
+package wire
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ConfigStruct is a configuration struct to be connected as a component, created using Config.
+type ConfigStruct struct {
+	prefix string
+	value  reflect.Value
+}
+
+// Config creates a configuration struct T to be connected as a component, for example:
+//
+//	wire.Connect(wire.Config[DBConfig]("db"))
+//
+// Once wiring applied, fields of T are bound from config sources using key prefixed by prefix.
+// Key of each field is the lower cased field name, or specified using `config` tag together with it's options:
+//
+//	type DBConfig struct {
+//		Host    string        `config:",required"`
+//		Port    int           `config:"port,default=5432"`
+//		Timeout time.Duration `config:"timeout,default=5s"`
+//		Secret  string        `config:"-"`
+//	}
+//
+// Default value is used when no value is found in config sources, and must be the last option.
+// Required field is rejected when it's zero after binding.
+func Config[T any](prefix string) ConfigStruct {
+	rt := reflect.TypeOf((*T)(nil)).Elem()
+	if rt.Kind() != reflect.Struct {
+		panic(configParamError{paramType: rt})
+	}
+
+	return ConfigStruct{
+		prefix: prefix,
+		value:  reflect.New(rt),
+	}
+}
+
+// Load config sources to be wired into fields tagged using `wire:"config=key"`.
+// Sources loaded later take precedence over sources loaded earlier.
+func (container Container) Load(sources ...Source) {
+	*container.sources = append(*container.sources, sources...)
+}
+
+func (container Container) lookupConfig(key string) (interface{}, bool) {
+	sources := *container.sources
+	for i := len(sources) - 1; i >= 0; i-- {
+		if val, ok := sources[i].Lookup(key); ok {
+			return val, true
+		}
+	}
+
+	return nil, false
+}
+
+// bind config value to the dependency field of c.
+func (container Container) bind(c *component, dep dependency) {
+	if _, ok := container.lookupConfig(dep.config); !ok && !dep.hasDefault {
+		panic(configNotFoundError{component: *c, dependency: dep})
+	}
+
+	container.bindValue(c, c.value.Field(dep.index), dep)
+}
+
+// bindStruct binds fields of config struct rv from config sources, nested struct are bound using their field key as prefix.
+func (container Container) bindStruct(c *component, rv reflect.Value, prefix string, path string) {
+	rt := rv.Type()
+
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		tval := sf.Tag.Get("config")
+
+		if sf.PkgPath != "" || tval == "-" {
+			continue
+		}
+
+		// config tag is in form of "key,required,default=value", the key defaults to lower cased field name.
+		dep := parseTag(tval)
+		dep.config, dep.id, dep.impl = dep.id, "", ""
+		if dep.config == "" {
+			dep.config = strings.ToLower(sf.Name)
+		}
+
+		dep.name = path + sf.Name
+		dep.index = i
+		dep.typ = sf.Type
+		if prefix != "" {
+			dep.config = prefix + "." + dep.config
+		}
+
+		fv := rv.Field(i)
+		if sf.Type.Kind() == reflect.Struct && !leaf(sf.Type) {
+			container.bindStruct(c, fv, dep.config, dep.name+".")
+			continue
+		}
+
+		container.bindValue(c, fv, dep)
+	}
+}
+
+// bindValue binds config value of dep into fv, using it's default value when no config is found.
+func (container Container) bindValue(c *component, fv reflect.Value, dep dependency) {
+	var (
+		val interface{}
+		ok  bool
+	)
+
+	if dep.config != "" {
+		val, ok = container.lookupConfig(dep.config)
+	}
+
+	if !ok && dep.hasDefault {
+		val, ok = dep.def, true
+	}
+
+	if ok {
+		cv, err := convert(val, fv.Type())
+		if err != nil {
+			panic(configConvertError{component: *c, dependency: dep, value: val, err: err})
+		}
+
+		fv.Set(cv)
+	}
+
+	if dep.required && fv.IsZero() {
+		panic(requiredError{component: *c, dependency: dep})
+	}
+}
+
+// leaf reports whether struct typ is converted from a single config value instead of bound field by field.
+func leaf(typ reflect.Type) bool {
+	return reflect.PtrTo(typ).Implements(textUnmarshalerType)
+}
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// convert config value decoded from a source into typ.
+func convert(val interface{}, typ reflect.Type) (reflect.Value, error) {
+	rv := reflect.ValueOf(val)
+	if !rv.IsValid() {
+		return reflect.Zero(typ), nil
+	}
+
+	if rv.Type().AssignableTo(typ) {
+		return rv, nil
+	}
+
+	if typ.Kind() == reflect.Ptr {
+		elem, err := convert(val, typ.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		ptr := reflect.New(typ.Elem())
+		ptr.Elem().Set(elem)
+		return ptr, nil
+	}
+
+	if str, ok := val.(string); ok && reflect.PtrTo(typ).Implements(textUnmarshalerType) {
+		ptr := reflect.New(typ)
+		if err := ptr.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(str)); err != nil {
+			return reflect.Value{}, err
+		}
+
+		return ptr.Elem(), nil
+	}
+
+	out := reflect.New(typ).Elem()
+
+	if typ == durationType {
+		if str, ok := val.(string); ok {
+			d, err := time.ParseDuration(str)
+			out.SetInt(int64(d))
+			return out, err
+		}
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		switch rv.Kind() {
+		case reflect.String, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			out.SetString(fmt.Sprint(val))
+			return out, nil
+		case reflect.Float32, reflect.Float64:
+			// json numbers are decoded as float64, format them without exponent.
+			out.SetString(strconv.FormatFloat(rv.Float(), 'f', -1, rv.Type().Bits()))
+			return out, nil
+		}
+	case reflect.Bool:
+		switch rv.Kind() {
+		case reflect.Bool:
+			out.SetBool(rv.Bool())
+			return out, nil
+		case reflect.String:
+			b, err := strconv.ParseBool(rv.String())
+			out.SetBool(b)
+			return out, err
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := toInt(rv, typ.Bits())
+		out.SetInt(i)
+		return out, err
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := toUint(rv, typ.Bits())
+		out.SetUint(u)
+		return out, err
+	case reflect.Float32, reflect.Float64:
+		f, err := toFloat(rv, typ.Bits())
+		out.SetFloat(f)
+		return out, err
+	case reflect.Slice:
+		return convertSlice(rv, typ)
+	case reflect.Map:
+		return convertMap(val, typ)
+	}
+
+	return reflect.Value{}, errors.New("unsupported conversion from " + rv.Type().String())
+}
+
+func toInt(rv reflect.Value, bits int) (int64, error) {
+	var i int64
+
+	switch rv.Kind() {
+	case reflect.String:
+		return strconv.ParseInt(strings.TrimSpace(rv.String()), 10, bits)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i = rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if rv.Uint() > math.MaxInt64 {
+			return 0, errors.New("value out of range")
+		}
+
+		i = int64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if f != math.Trunc(f) || f > math.MaxInt64 || f < math.MinInt64 {
+			return 0, errors.New("value is not an integer")
+		}
+
+		i = int64(f)
+	default:
+		return 0, errors.New("unsupported conversion from " + rv.Type().String())
+	}
+
+	if bits < 64 && (i > 1<<uint(bits-1)-1 || i < -1<<uint(bits-1)) {
+		return 0, errors.New("value out of range")
+	}
+
+	return i, nil
+}
+
+func toUint(rv reflect.Value, bits int) (uint64, error) {
+	var u uint64
+
+	switch rv.Kind() {
+	case reflect.String:
+		return strconv.ParseUint(strings.TrimSpace(rv.String()), 10, bits)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if rv.Int() < 0 {
+			return 0, errors.New("value out of range")
+		}
+
+		u = uint64(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u = rv.Uint()
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if f != math.Trunc(f) || f < 0 || f >= math.MaxUint64 {
+			return 0, errors.New("value is not an integer")
+		}
+
+		u = uint64(f)
+	default:
+		return 0, errors.New("unsupported conversion from " + rv.Type().String())
+	}
+
+	if bits < 64 && u > 1<<uint(bits)-1 {
+		return 0, errors.New("value out of range")
+	}
+
+	return u, nil
+}
+
+func toFloat(rv reflect.Value, bits int) (float64, error) {
+	switch rv.Kind() {
+	case reflect.String:
+		return strconv.ParseFloat(strings.TrimSpace(rv.String()), bits)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	}
+
+	return 0, errors.New("unsupported conversion from " + rv.Type().String())
+}
+
+// convertSlice converts a list, or comma separated string usually found in environment variable.
+func convertSlice(rv reflect.Value, typ reflect.Type) (reflect.Value, error) {
+	var items []interface{}
+
+	switch rv.Kind() {
+	case reflect.String:
+		if rv.String() != "" {
+			for _, item := range strings.Split(rv.String(), ",") {
+				items = append(items, strings.TrimSpace(item))
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			items = append(items, rv.Index(i).Interface())
+		}
+	default:
+		return reflect.Value{}, errors.New("unsupported conversion from " + rv.Type().String())
+	}
+
+	out := reflect.MakeSlice(typ, len(items), len(items))
+	for i, item := range items {
+		iv, err := convert(item, typ.Elem())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("index %d: %v", i, err)
+		}
+
+		out.Index(i).Set(iv)
+	}
+
+	return out, nil
+}
+
+func convertMap(val interface{}, typ reflect.Type) (reflect.Value, error) {
+	m, ok := normalizeMap(val)
+	if !ok || typ.Key().Kind() != reflect.String {
+		return reflect.Value{}, errors.New("unsupported conversion from " + reflect.TypeOf(val).String())
+	}
+
+	out := reflect.MakeMapWithSize(typ, len(m))
+	for k, v := range m {
+		ev, err := convert(v, typ.Elem())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("key %s: %v", k, err)
+		}
+
+		out.SetMapIndex(reflect.ValueOf(k).Convert(typ.Key()), ev)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,384 @@
+package wire_test
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Fs02/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+type Settings struct {
+	Name     string            `wire:"config=app.name"`
+	Port     int               `wire:"config=db.port"`
+	Timeout  time.Duration     `wire:"config=db.timeout"`
+	Debug    bool              `wire:"config=debug"`
+	Ratio    float32           `wire:"config=ratio"`
+	Workers  *uint8            `wire:"config=workers"`
+	Hosts    []string          `wire:"config=db.hosts"`
+	Ports    []int             `wire:"config=db.ports"`
+	Labels   map[string]string `wire:"config=labels"`
+	Deadline time.Time         `wire:"config=deadline"`
+}
+
+func TestContainer_Load(t *testing.T) {
+	var (
+		workers  = uint8(4)
+		settings = Settings{}
+		deadline = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	)
+
+	app := wire.New()
+	app.Load(wire.MapSource(map[string]interface{}{
+		"app": map[string]interface{}{
+			"name": "CoolApp",
+		},
+		"db": map[interface{}]interface{}{
+			"port":    5432,
+			"timeout": "5s",
+			"hosts":   []interface{}{"a", "b"},
+			"ports":   "1, 2",
+		},
+		"debug":    "true",
+		"ratio":    0.5,
+		"workers":  float64(4),
+		"labels":   map[string]interface{}{"env": "test"},
+		"deadline": "2020-01-02T03:04:05Z",
+	}))
+	app.Connect(&settings)
+	app.Apply()
+
+	assert.Equal(t, Settings{
+		Name:     "CoolApp",
+		Port:     5432,
+		Timeout:  5 * time.Second,
+		Debug:    true,
+		Ratio:    0.5,
+		Workers:  &workers,
+		Hosts:    []string{"a", "b"},
+		Ports:    []int{1, 2},
+		Labels:   map[string]string{"env": "test"},
+		Deadline: deadline,
+	}, settings)
+}
+
+func TestContainer_Load_numbers(t *testing.T) {
+	var numbers struct {
+		Text  string `wire:"config=text"`
+		Ratio string `wire:"config=ratio"`
+		Max   uint64 `wire:"config=max"`
+		Byte  uint8  `wire:"config=byte"`
+	}
+
+	app := wire.New()
+	app.Load(wire.MapSource(map[string]interface{}{
+		"text":  float64(1000000),
+		"ratio": 0.25,
+		"max":   "18446744073709551615",
+		"byte":  uint64(255),
+	}))
+	app.Connect(&numbers)
+	app.Apply()
+
+	assert.Equal(t, "1000000", numbers.Text)
+	assert.Equal(t, "0.25", numbers.Ratio)
+	assert.Equal(t, uint64(math.MaxUint64), numbers.Max)
+	assert.Equal(t, uint8(255), numbers.Byte)
+}
+
+func TestContainer_Load_precedence(t *testing.T) {
+	os.Setenv("WIRETEST_APP_NAME", "EnvApp")
+	defer os.Unsetenv("WIRETEST_APP_NAME")
+
+	src, err := wire.JSONSource([]byte(`{"app": {"name": "JSONApp"}}`))
+	assert.Nil(t, err)
+
+	var component struct {
+		Name string `wire:"config=app.name"`
+	}
+
+	app := wire.New()
+	app.Load(src, wire.EnvSource("wiretest"))
+	app.Connect(&component)
+	app.Apply()
+
+	assert.Equal(t, "EnvApp", component.Name)
+}
+
+func TestContainer_Load_file(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	assert.Nil(t, os.WriteFile(path, []byte(`{"db": {"port": 3306}}`), 0600))
+
+	src, err := wire.JSONFile(path)
+	assert.Nil(t, err)
+
+	var component struct {
+		Port int `wire:"config=db.port"`
+	}
+
+	app := wire.New()
+	app.Load(src)
+	app.Connect(&component)
+	app.Apply()
+
+	assert.Equal(t, 3306, component.Port)
+
+	_, err = wire.JSONFile(filepath.Join(dir, "notexist.json"))
+	assert.NotNil(t, err)
+
+	assert.Nil(t, os.WriteFile(path, []byte(`{`), 0600))
+	_, err = wire.JSONFile(path)
+	assert.NotNil(t, err)
+}
+
+func TestContainer_Load_yaml(t *testing.T) {
+	var component struct {
+		Host    string        `wire:"config=db.host"`
+		Port    int           `wire:"config=db.port"`
+		Timeout time.Duration `wire:"config=db.timeout"`
+		Hosts   []string      `wire:"config=db.replicas"`
+	}
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.Nil(t, os.WriteFile(path, []byte("db:\n  host: localhost\n  port: 5432\n  timeout: 5s\n  replicas: [a, b]\n"), 0600))
+
+	src, err := wire.YAMLFile(path)
+	assert.Nil(t, err)
+
+	app := wire.New()
+	app.Load(src)
+	app.Connect(&component)
+	app.Apply()
+
+	assert.Equal(t, "localhost", component.Host)
+	assert.Equal(t, 5432, component.Port)
+	assert.Equal(t, 5*time.Second, component.Timeout)
+	assert.Equal(t, []string{"a", "b"}, component.Hosts)
+
+	_, err = wire.YAMLSource([]byte("db: ["))
+	assert.NotNil(t, err)
+}
+
+func TestContainer_Load_notFound(t *testing.T) {
+	var component struct {
+		Port int `wire:"config=db.port"`
+	}
+
+	app := wire.New()
+	app.Load(wire.MapSource(nil))
+	app.Connect(&component)
+
+	assert.Panics(t, func() {
+		app.Apply()
+	})
+}
+
+func TestContainer_Load_convertError(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		out   interface{}
+	}{
+		{name: "int", value: "abc", out: &struct {
+			Value int `wire:"config=value"`
+		}{}},
+		{name: "int overflow", value: 300, out: &struct {
+			Value int8 `wire:"config=value"`
+		}{}},
+		{name: "int fraction", value: 1.5, out: &struct {
+			Value int `wire:"config=value"`
+		}{}},
+		{name: "uint negative", value: -1, out: &struct {
+			Value uint `wire:"config=value"`
+		}{}},
+		{name: "uint overflow", value: "256", out: &struct {
+			Value uint8 `wire:"config=value"`
+		}{}},
+		{name: "bool", value: "yes", out: &struct {
+			Value bool `wire:"config=value"`
+		}{}},
+		{name: "duration", value: "5 seconds", out: &struct {
+			Value time.Duration `wire:"config=value"`
+		}{}},
+		{name: "slice", value: []interface{}{"a"}, out: &struct {
+			Value []int `wire:"config=value"`
+		}{}},
+		{name: "map", value: "a", out: &struct {
+			Value map[string]string `wire:"config=value"`
+		}{}},
+		{name: "struct", value: "a", out: &struct {
+			Value struct{} `wire:"config=value"`
+		}{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			app := wire.New()
+			app.Load(wire.MapSource(map[string]interface{}{"value": test.value}))
+			app.Connect(test.out)
+
+			assert.Panics(t, func() {
+				app.Apply()
+			})
+		})
+	}
+}
+
+type TLSConfig struct {
+	Cert string `config:",required"`
+	Key  string `config:"key_file,default=server.key"`
+}
+
+type DBConfig struct {
+	Host    string        `config:",required"`
+	Port    int           `config:"port,default=5432"`
+	Timeout time.Duration `config:"timeout,default=5s"`
+	Hosts   []string      `config:"hosts,default=a,b"`
+	Secret  string        `config:"-"`
+	TLS     TLSConfig
+	Since   time.Time
+	private int
+}
+
+type Repository struct {
+	Config *DBConfig `wire:""`
+}
+
+func TestConfig(t *testing.T) {
+	repository := Repository{}
+
+	app := wire.New()
+	app.Load(wire.MapSource(map[string]interface{}{
+		"db": map[string]interface{}{
+			"host":  "localhost",
+			"tls":   map[string]interface{}{"cert": "server.crt"},
+			"since": "2020-01-02T03:04:05Z",
+		},
+	}))
+	app.Connect(wire.Config[DBConfig]("db"))
+	app.Connect(wire.Config[DBConfig](""), "root")
+	app.Connect(&repository)
+
+	assert.Panics(t, func() {
+		app.Apply()
+	})
+
+	app.Load(wire.MapSource(map[string]interface{}{
+		"host": "remote",
+		"port": "3306",
+		"tls":  map[string]interface{}{"cert": "remote.crt", "key_file": "remote.key"},
+	}))
+	app.Apply()
+
+	var root DBConfig
+	app.Resolve(&root, "root")
+
+	assert.Equal(t, DBConfig{
+		Host:    "localhost",
+		Port:    5432,
+		Timeout: 5 * time.Second,
+		Hosts:   []string{"a", "b"},
+		TLS:     TLSConfig{Cert: "server.crt", Key: "server.key"},
+		Since:   time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+	}, *repository.Config)
+
+	assert.Equal(t, DBConfig{
+		Host:    "remote",
+		Port:    3306,
+		Timeout: 5 * time.Second,
+		Hosts:   []string{"a", "b"},
+		TLS:     TLSConfig{Cert: "remote.crt", Key: "remote.key"},
+	}, root)
+}
+
+func TestConfig_convertError(t *testing.T) {
+	app := wire.New()
+	app.Load(wire.MapSource(map[string]interface{}{"host": "localhost", "port": "abc"}))
+	app.Connect(wire.Config[DBConfig](""))
+
+	assert.Panics(t, func() {
+		app.Apply()
+	})
+}
+
+func TestConfig_notStruct(t *testing.T) {
+	assert.Panics(t, func() {
+		wire.Config[string]("app")
+	})
+}
+
+func TestContainer_Apply_default(t *testing.T) {
+	var component struct {
+		App     string        `wire:",default=CoolApp"`
+		Named   string        `wire:"named,default=a,b"`
+		Port    int           `wire:"config=db.port,default=5432"`
+		Timeout time.Duration `wire:"config=timeout,required,default=1s"`
+		Debug   bool          `wire:",default=true"`
+		Valuer  Valuer        `wire:",default="`
+	}
+
+	app := wire.New()
+	app.Connect(false)
+	app.Profile("prod").Connect(ComponentA{Value1: "Prod!"})
+	app.Connect(&component)
+
+	// interface can't be converted from default value.
+	assert.Panics(t, func() {
+		app.Apply()
+	})
+
+	app.Activate("prod")
+	app.Apply()
+
+	assert.Equal(t, "CoolApp", component.App)
+	assert.Equal(t, "a,b", component.Named)
+	assert.Equal(t, 5432, component.Port)
+	assert.Equal(t, time.Second, component.Timeout)
+	assert.Equal(t, false, component.Debug)
+	assert.Equal(t, "Prod!", component.Valuer.Value())
+}
+
+func TestContainer_Apply_required(t *testing.T) {
+	var component struct {
+		App string `wire:",required"`
+	}
+
+	app := wire.New()
+	app.Connect("")
+	app.Connect(&component)
+
+	assert.Panics(t, func() {
+		app.Apply()
+	})
+}
+
+func TestContainer_Apply_requiredConfig(t *testing.T) {
+	var component struct {
+		App string `wire:"config=app.name,required"`
+	}
+
+	app := wire.New()
+	app.Load(wire.MapSource(map[string]interface{}{"app.name": ""}))
+	app.Connect(&component)
+
+	assert.Panics(t, func() {
+		app.Apply()
+	})
+}
+
+func TestContainer_Apply_defaultConvertError(t *testing.T) {
+	var component struct {
+		Port int `wire:",default=abc"`
+	}
+
+	app := wire.New()
+	app.Connect(&component)
+
+	assert.Panics(t, func() {
+		app.Apply()
+	})
+}
@@ -1,10 +1,15 @@
 package wire
 
 import (
+	"io"
 	"reflect"
 	"runtime"
 	"strconv"
-	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Fs02/wire/internal/wiretag"
 )
 
 const tag = "wire"
@@ -14,39 +19,118 @@ type component struct {
 	value        reflect.Value
 	dependencies []dependency
 	declaredAt   string
+	module       *Module
+	profiles     []string
+	disabled     bool
+	alias        *component
+	replaced     string
+	config       *ConfigStruct
+	decorated    map[reflect.Type]reflect.Value
+	decorating   *sync.Mutex
+	filling      bool
+	filled       bool
+	used         *atomic.Bool
+	duration     time.Duration
+}
+
+// location describes where the component is declared, an alias also points back to it's original.
+func (c component) location() string {
+	loc := c.declaredAt
+	if c.module != nil {
+		loc += " in module \"" + c.module.name + "\""
+	}
+
+	if c.alias != nil {
+		return loc + " (alias of \"" + c.alias.id + "\" declared at " + c.alias.location() + ")"
+	}
+
+	if c.replaced != "" {
+		return loc + " (replaces " + c.replaced + ")"
+	}
+
+	return loc
 }
 
 type dependency struct {
-	id    string
-	name  string
-	index int
-	typ   reflect.Type
-	impl  string
+	id         string
+	name       string
+	index      int
+	typ        reflect.Type
+	impl       string
+	config     string
+	def        string
+	hasDefault bool
+	required   bool
+}
+
+// parseTag parses `wire` tag into dependency, see wiretag.Tag for the tag format.
+// `config` tag of config struct in form of "key,required,default=value" is parsed the same way, with key parsed as id.
+func parseTag(tval string) dependency {
+	tag := wiretag.Parse(tval)
+
+	return dependency{
+		id:         tag.ID(),
+		impl:       tag.Impl(),
+		config:     tag.Config,
+		def:        tag.Default,
+		hasDefault: tag.HasDefault,
+		required:   tag.Required,
+	}
 }
 
-type group []component
+type group []*component
 
-func (gr group) find(id string) (component, bool) {
+func (gr group) find(id string) (*component, bool) {
 	for _, c := range gr {
 		if c.id == id {
 			return c, true
 		}
 	}
 
-	return component{}, false
+	return nil, false
+}
+
+// identifies reports whether dep should be resolved from the group, group of interface type only contains aliases,
+// so it's only used when one of them is identified by the id of dep.
+func (gr group) identifies(dep dependency) bool {
+	if dep.typ.Kind() != reflect.Interface {
+		return true
+	}
+
+	_, ok := gr.find(dep.id)
+	return ok
 }
 
-func (gr group) get(id string) component {
+// conflict finds component identified by the same id that can be active together with c.
+func (gr group) conflict(c *component) (*component, bool) {
+	for _, prev := range gr {
+		if prev.id == c.id && conflicts(prev, c) {
+			return prev, true
+		}
+	}
+
+	return nil, false
+}
+
+func (gr group) get(typ reflect.Type, id string) *component {
 	if c, ok := gr.find(id); ok {
 		return c
 	}
 
-	panic(idNotFoundError{id: id, component: gr[0]})
+	panic(idNotFoundError{id: id, paramType: typ, ids: gr.ids()})
 }
 
 // Container provides an isolated container for DI.
 type Container struct {
 	components map[reflect.Type]group
+	modules    map[*Module]string
+	active     map[string]bool
+	sources    *[]Source
+	decorators map[reflect.Type][]*decorator
+	module     *Module
+	profiles   []string
+	trace      *io.Writer
+	listeners  *[]Listener
 	callerSkip int
 }
 
@@ -54,25 +138,327 @@ type Container struct {
 func New() Container {
 	return Container{
 		components: make(map[reflect.Type]group),
+		modules:    make(map[*Module]string),
+		active:     envProfiles(),
+		sources:    &[]Source{},
+		decorators: make(map[reflect.Type][]*decorator),
+		trace:      new(io.Writer),
+		listeners:  &[]Listener{},
 	}
 }
 
+// CloneOption configures how a container is cloned.
+type CloneOption int
+
+const (
+	// ShareValues shares components connected by reference between container and it's clone instead of copying them.
+	ShareValues CloneOption = iota + 1
+)
+
+// Clone creates a copy of container with it's own registrations.
+// Components connected by reference are copied unless ShareValues is given,
+// so applying wiring or replacing components in the clone doesn't affect container.
+func (container Container) Clone(opts ...CloneOption) Container {
+	copyValues := true
+	for _, opt := range opts {
+		if opt == ShareValues {
+			copyValues = false
+		}
+	}
+
+	return container.clone(copyValues)
+}
+
+// Merge imports components of other container, components are shared between both containers.
+// This will panic without importing any component if both containers have a component with the same type and id.
+func (container Container) Merge(other Container) {
+	imported := other.clone(false)
+
+	for typ, gr := range imported.components {
+		for _, c := range gr {
+			if prev, ok := container.components[typ].conflict(c); ok {
+				panic(duplicateError{previous: *prev, current: *c})
+			}
+		}
+	}
+
+	for typ, gr := range imported.components {
+		container.components[typ] = append(container.components[typ], gr...)
+		for _, c := range gr {
+			container.emitComponent(Connected, c)
+		}
+	}
+
+	for mod, at := range imported.modules {
+		container.modules[mod] = at
+	}
+
+	for typ, decs := range imported.decorators {
+		container.decorators[typ] = append(container.decorators[typ], decs...)
+	}
+}
+
+// Install components of a module and all modules it includes.
+// Modules included by more than one installed module are only installed once.
+// This will panic without installing any module if a component conflicts with an existing one.
+func (container Container) Install(mod *Module) {
+	if at, ok := container.modules[mod]; ok {
+		panic(moduleInstalledError{module: mod, previous: at})
+	}
+
+	container.install(mod, container.caller(1))
+}
+
+// install checks components of every module to be installed before importing any of them,
+// so an install that panics leaves container unchanged.
+func (container Container) install(mod *Module, declaredAt string) {
+	mods := container.uninstalled(mod, make(map[*Module]bool))
+
+	staged := make(map[reflect.Type]group)
+	for _, m := range mods {
+		for typ, gr := range m.container.components {
+			for _, c := range gr {
+				prev, ok := container.components[typ].conflict(c)
+				if !ok {
+					prev, ok = staged[typ].conflict(c)
+				}
+
+				if ok {
+					panic(duplicateError{previous: *prev, current: *c})
+				}
+
+				staged[typ] = append(staged[typ], c)
+			}
+		}
+	}
+
+	for _, m := range mods {
+		container.modules[m] = declaredAt
+		container.Merge(m.container)
+	}
+}
+
+// uninstalled lists mod and the modules it includes that are not installed yet, included modules come first.
+func (container Container) uninstalled(mod *Module, seen map[*Module]bool) []*Module {
+	if _, ok := container.modules[mod]; ok || seen[mod] {
+		return nil
+	}
+
+	seen[mod] = true
+
+	var mods []*Module
+	for _, include := range mod.includes {
+		mods = append(mods, container.uninstalled(include, seen)...)
+	}
+
+	return append(mods, mod)
+}
+
+// Snapshot captures the registrations of container, the returned function restores container to the captured state.
+// It's intended for tests that connect or replace components in a shared container.
+func (container Container) Snapshot() (restore func()) {
+	saved := container.clone(false)
+
+	return func() {
+		for typ := range container.components {
+			delete(container.components, typ)
+		}
+
+		restored := saved.clone(false)
+		for typ, gr := range restored.components {
+			container.components[typ] = gr
+		}
+
+		for mod := range container.modules {
+			delete(container.modules, mod)
+		}
+
+		for mod, at := range restored.modules {
+			container.modules[mod] = at
+		}
+
+		for profile := range container.active {
+			delete(container.active, profile)
+		}
+
+		for profile := range restored.active {
+			container.active[profile] = true
+		}
+
+		*container.sources = *restored.sources
+		*container.listeners = *restored.listeners
+
+		for typ := range container.decorators {
+			delete(container.decorators, typ)
+		}
+
+		for typ, decs := range restored.decorators {
+			container.decorators[typ] = decs
+		}
+	}
+}
+
+func (container Container) clone(copyValues bool) Container {
+	clone := New()
+	for mod, at := range container.modules {
+		clone.modules[mod] = at
+	}
+
+	for profile := range container.active {
+		clone.active[profile] = true
+	}
+
+	*clone.sources = append(*clone.sources, *container.sources...)
+	*clone.trace = *container.trace
+	*clone.listeners = append(*clone.listeners, *container.listeners...)
+
+	for typ, decs := range container.decorators {
+		clone.decorators[typ] = append([]*decorator(nil), decs...)
+	}
+
+	copies := make(map[*component]*component)
+	for typ, gr := range container.components {
+		cgr := make(group, len(gr))
+		for i, c := range gr {
+			cc := *c
+			cc.filled = false
+			cc.duration = 0
+			cc.used = &atomic.Bool{}
+			cc.decorated = nil
+			cc.decorating = &sync.Mutex{}
+			if copyValues && c.alias == nil && c.value.CanAddr() {
+				cc.value = reflect.New(c.value.Type()).Elem()
+				cc.value.Set(c.value)
+			}
+
+			cgr[i] = &cc
+			copies[c] = &cc
+		}
+
+		clone.components[typ] = cgr
+	}
+
+	// point aliases to their cloned original.
+	for _, gr := range clone.components {
+		for _, c := range gr {
+			if c.alias != nil {
+				c.alias = copies[c.alias]
+				c.value = c.alias.value
+			}
+		}
+	}
+
+	return clone
+}
+
 // Connect a component, optionally identified by id.
 func (container Container) Connect(val interface{}, id ...string) {
+	container.connect(container.component(val, container.caller(1), id...))
+}
+
+// ConnectIf connects a component only if cond is true, optionally identified by id.
+// Component that is not connected is still reported when it's needed as a dependency, and conflicts with components of the same type and id.
+func (container Container) ConnectIf(cond bool, val interface{}, id ...string) {
+	comp := container.component(val, container.caller(1), id...)
+	comp.disabled = !cond
+	container.connect(comp)
+}
+
+func (container Container) connect(comp *component) {
+	rt := comp.value.Type()
+
+	if gr, ok := container.components[rt]; ok {
+		if prev, ok := gr.conflict(comp); ok {
+			panic(duplicateError{previous: *prev})
+		}
+	}
+
+	container.components[rt] = append(container.components[rt], comp)
+	container.emitComponent(Connected, comp)
+}
+
+// Replace a connected component with the same type and id, usually to substitute a fake in tests.
+// Replace must be called before wiring applied, aliases of the previous component will point to the replacement.
+// Replacement without profile replaces components of every profile, while keeping their profile.
+// Component connected using ConnectIf is replaced too, the replacement is only connected when the condition was true.
+func (container Container) Replace(val interface{}, id ...string) {
+	comp := container.component(val, container.caller(1), id...)
+	rt := comp.value.Type()
+
+	gr, ok := container.components[rt]
+	if !ok {
+		panic(typeNotFoundError{paramType: rt})
+	}
+
+	replaced := 0
+	for _, prev := range gr {
+		if prev.id != comp.id || !conflicts(prev, comp) {
+			continue
+		}
+
+		replacement := *comp
+		replacement.replaced = prev.location()
+		replacement.disabled = prev.disabled
+		if len(comp.profiles) == 0 {
+			replacement.profiles = prev.profiles
+		}
+
+		*prev = replacement
+		replaced++
+		container.realias(rt, prev)
+		container.emitComponent(Replaced, prev)
+	}
+
+	if replaced == 0 {
+		panic(idNotFoundError{id: comp.id, paramType: rt, ids: gr.ids()})
+	}
+}
+
+// realias points aliases of replaced component to it's new value.
+func (container Container) realias(rt reflect.Type, replaced *component) {
+	for typ, gr := range container.components {
+		for _, c := range gr {
+			if c.alias != replaced {
+				continue
+			}
+
+			if typ.Kind() == reflect.Interface && !replaced.value.Type().Implements(typ) && !replaced.value.CanAddr() {
+				panic(notAddressableError{id: replaced.id, paramType: reflect.PtrTo(rt), component: *replaced})
+			}
+
+			c.value = replaced.value
+			c.profiles = replaced.profiles
+			c.disabled = replaced.disabled
+		}
+	}
+}
+
+// component creates a component from val and collects it's dependencies.
+func (container Container) component(val interface{}, declaredAt string, id ...string) *component {
 	ptr := false
 	rv := reflect.ValueOf(val)
 	rt := rv.Type()
 	nam := ""
-	_, file, no, _ := runtime.Caller(container.callerSkip + 1)
 
 	if len(id) > 0 {
 		nam = id[0]
 	}
 
-	comp := component{
+	comp := &component{
 		id:         nam,
 		value:      rv,
-		declaredAt: file + ":" + strconv.Itoa(no),
+		declaredAt: declaredAt,
+		module:     container.module,
+		profiles:   container.profiles,
+		decorating: &sync.Mutex{},
+		used:       &atomic.Bool{},
+	}
+
+	// config struct is bound from config sources instead of wired.
+	if cs, ok := val.(ConfigStruct); ok {
+		comp.value = cs.value.Elem()
+		comp.config = &cs
+		return comp
 	}
 
 	if rt.Kind() == reflect.Ptr {
@@ -82,15 +468,8 @@ func (container Container) Connect(val interface{}, id ...string) {
 		ptr = true
 	}
 
-	if gr, ok := container.components[rt]; ok {
-		if comp, ok := gr.find(nam); ok {
-			panic(duplicateError{previous: comp})
-		}
-	}
-
 	if rt.Kind() != reflect.Struct {
-		container.components[rt] = append(container.components[rt], comp)
-		return
+		return comp
 	}
 
 	for i := 0; i < rt.NumField(); i++ {
@@ -113,21 +492,12 @@ func (container Container) Connect(val interface{}, id ...string) {
 				depRt = depRt.Elem()
 			}
 
-			idAndImpl := strings.Split(tval, ",")
-			id := idAndImpl[0]
-			impl := ""
+			dep := parseTag(tval)
+			dep.name = sf.Name
+			dep.index = i
+			dep.typ = depRt
 
-			if len(idAndImpl) > 1 {
-				impl = idAndImpl[1]
-			}
-
-			comp.dependencies = append(comp.dependencies, dependency{
-				id:    id,
-				name:  sf.Name,
-				index: i,
-				typ:   depRt,
-				impl:  impl,
-			})
+			comp.dependencies = append(comp.dependencies, dep)
 		} else if (sf.Type.Kind() == reflect.Ptr || sf.Type.Kind() == reflect.Interface) && rv.Field(i).IsNil() {
 			panic(tagMissingError{field: sf})
 		} else if sf.Type.Kind() == reflect.Struct {
@@ -142,7 +512,84 @@ func (container Container) Connect(val interface{}, id ...string) {
 		panic(incompletedError{})
 	}
 
-	container.components[rt] = append(container.components[rt], comp)
+	return comp
+}
+
+// Alias exposes a connected component under another id without copying it.
+// val is only used to determine the type of the component, for example (*DB)(nil) or DB{}.
+func (container Container) Alias(val interface{}, id string, alias string) {
+	rt := reflect.TypeOf(val)
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	container.alias(rt, id, rt, alias)
+}
+
+// AliasAs exposes a connected component as an interface it implements, identified by alias.
+// Fields with the interface type are then wired directly to the component without scanning other implementations.
+// iface must be a pointer to the interface, for example (*Printer)(nil).
+func (container Container) AliasAs(val interface{}, id string, iface interface{}, alias string) {
+	rt := reflect.TypeOf(val)
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	as := reflect.TypeOf(iface)
+	if as.Kind() != reflect.Ptr || as.Elem().Kind() != reflect.Interface {
+		panic(aliasParamError{})
+	}
+
+	container.alias(rt, id, as.Elem(), alias)
+}
+
+func (container Container) alias(rt reflect.Type, id string, as reflect.Type, alias string) {
+	gr, ok := container.components[rt]
+	if !ok {
+		panic(typeNotFoundError{paramType: rt})
+	}
+
+	gr.get(rt, id)
+	declaredAt := container.caller(2)
+
+	// alias every component identified by id, each keeps the profile of it's original.
+	for _, orig := range gr {
+		if orig.id != id {
+			continue
+		}
+
+		if orig.alias != nil {
+			orig = orig.alias
+		}
+
+		comp := &component{
+			id:         alias,
+			value:      orig.value,
+			declaredAt: declaredAt,
+			profiles:   orig.profiles,
+			disabled:   orig.disabled,
+			alias:      orig,
+			decorating: &sync.Mutex{},
+			used:       &atomic.Bool{},
+		}
+
+		if prev, ok := container.components[as].conflict(comp); ok {
+			panic(duplicateError{previous: *prev})
+		}
+
+		if as.Kind() == reflect.Interface && !orig.value.Type().Implements(as) {
+			if !reflect.PtrTo(orig.value.Type()).Implements(as) {
+				panic(aliasTypeError{aliasType: as, component: *orig})
+			}
+
+			if !orig.value.CanAddr() {
+				panic(notAddressableError{id: id, paramType: reflect.PtrTo(rt), component: *orig})
+			}
+		}
+
+		container.components[as] = append(container.components[as], comp)
+		container.emitComponent(Connected, comp)
+	}
 }
 
 // Resolve a component with identified id.
@@ -161,20 +608,31 @@ func (container Container) Resolve(out interface{}, id ...string) {
 		nam = id[0]
 	}
 
+	container.emit(Event{Kind: ResolveCalled, Type: rt, ID: nam})
+
 	if rt.Kind() == reflect.Ptr {
 		// pointer inside pointer
 		if gr, ok := container.components[rt.Elem()]; ok {
-			comp := gr.get(nam)
+			comp := container.get(rt.Elem(), gr, nam)
+			comp.use()
 			if comp.value.CanAddr() {
-				rv.Set(comp.value.Addr())
+				rv.Set(container.decorate(comp, rt, comp.value.Addr()))
 				return
 			}
 
-			panic(notAddressableError{id: nam, paramType: rt, component: comp})
+			panic(notAddressableError{id: nam, paramType: rt, component: *comp})
 		}
 	} else {
 		if gr, ok := container.components[rt]; ok {
-			rv.Set(gr.get(nam).value)
+			comp := container.get(rt, gr, nam)
+			comp.use()
+			val := comp.value
+			if !val.Type().AssignableTo(rt) {
+				// aliased as interface implemented by pointer receiver.
+				val = val.Addr()
+			}
+
+			rv.Set(container.decorate(comp, rt, val.Convert(rt)))
 			return
 		}
 	}
@@ -184,76 +642,194 @@ func (container Container) Resolve(out interface{}, id ...string) {
 
 // Apply wiring to all components.
 func (container Container) Apply() {
+	container.emit(Event{Kind: ApplyStarted})
+	defer container.finished(time.Now())
+
 	for _, gr := range container.components {
 		for _, comp := range gr {
-			container.fill(comp)
+			if container.isActive(comp) {
+				container.fill(comp)
+			}
 		}
 	}
 }
 
-func (container Container) fill(c component) {
-	if len(c.dependencies) == 0 {
+func (container Container) fill(c *component) {
+	if c.alias != nil {
+		container.fill(c.alias)
 		return
 	}
 
-	for i := range c.dependencies {
-		ptrInterface := false
-		dep := c.dependencies[i]
-		cdep := component{}
-
-		if gr, ok := container.components[dep.typ]; ok {
-			cdep = gr.get(dep.id)
-		} else {
-			// scan if it's interface
-			matches := 0
-
-			if dep.typ.Kind() == reflect.Interface {
-				for _, gr := range container.components {
-					ctyp := gr[0].value.Type()
-
-					if dep.impl != "" && dep.impl != ctyp.Name() {
-						continue
-					}
-
-					if ctyp.Implements(dep.typ) {
-						if fcedp, ok := gr.find(dep.id); ok {
-							cdep = fcedp
-							matches++
-							continue
-						}
-					}
-
-					// scan pointer type
-					if reflect.PtrTo(ctyp).Implements(dep.typ) {
-						if fcedp, ok := gr.find(dep.id); ok {
-							ptrInterface = true
-							cdep = fcedp
-							matches++
-						}
-					}
-				}
-			}
+	if c.filled || c.filling {
+		return
+	}
+
+	// marked while filling, so pointer cycles between components doesn't recurse forever.
+	c.filling = true
+	start, nested := time.Now(), time.Duration(0)
+	defer func() {
+		c.filling = false
+		c.duration = time.Since(start) - nested
+	}()
+
+	if c.config != nil {
+		container.bindStruct(c, c.value, c.config.prefix, "")
+		c.filled = true
+		return
+	}
+
+	for _, dep := range c.dependencies {
+		fv := c.value.Field(dep.index)
+
+		if dep.config != "" {
+			container.bind(c, dep)
+			continue
+		}
 
-			if matches == 0 {
-				panic(dependencyNotFound{id: dep.id, component: c, dependency: dep})
-			} else if matches > 1 {
-				panic(ambiguousError{component: c, dependency: dep})
+		container.traced(c, dep)
+		cdep, ptrInterface, err := container.resolve(c, dep)
+		if err != nil {
+			if !dep.hasDefault || !isNotFound(err) {
+				panic(err)
 			}
+
+			container.bindValue(c, fv, dep)
+			continue
 		}
 
+		cdep.use()
+		before := time.Now()
 		container.fill(cdep)
+		nested += time.Since(before)
 
-		fv := c.value.Field(dep.index)
+		val := cdep.value
 		if fv.Kind() == reflect.Ptr || ptrInterface {
 			if !cdep.value.CanAddr() {
-				panic(requiresPointerError{component: c, dependency: dep, depComponent: cdep})
+				panic(requiresPointerError{component: *c, dependency: dep, depComponent: *cdep})
+			}
+
+			val = val.Addr()
+		}
+
+		fv.Set(container.decorate(cdep, fv.Type(), val.Convert(fv.Type())))
+		container.emit(Event{
+			Kind:         DependencyResolved,
+			Type:         c.value.Type(),
+			ID:           c.id,
+			DeclaredAt:   c.declaredAt,
+			Field:        dep.name,
+			Dependency:   cdep.value.Type(),
+			DependencyID: cdep.id,
+		})
+
+		if dep.required && fv.IsZero() {
+			panic(requiredError{component: *c, dependency: dep})
+		}
+	}
+
+	c.filled = true
+}
+
+// resolve finds component to be wired into dep field of c,
+// ptrInterface reports whether the component satisfy the interface only as a pointer.
+func (container Container) resolve(c *component, dep dependency) (cdep *component, ptrInterface bool, err error) {
+	// interface implementations are scanned when none of the components aliased as the interface is identified by id.
+	if gr, ok := container.components[dep.typ]; ok && gr.identifies(dep) {
+		cdep, inactive := container.lookup(gr, dep.id)
+		if cdep == nil && inactive != nil {
+			return nil, false, inactiveError{component: c, dependency: dep, depComponent: *inactive}
+		} else if cdep == nil {
+			return nil, false, idNotFoundError{id: dep.id, paramType: dep.typ, ids: gr.ids()}
+		}
+
+		if !visible(c, cdep, dep.typ) {
+			return nil, false, privateError{component: *c, dependency: dep, depComponent: *cdep}
+		}
+
+		// aliased as interface implemented by pointer receiver.
+		ptrInterface = dep.typ.Kind() == reflect.Interface && !cdep.value.Type().Implements(dep.typ)
+		return cdep, ptrInterface, nil
+	}
+
+	// scan if it's interface
+	matches := 0
+	var private, inactive *component
+
+	if dep.typ.Kind() == reflect.Interface {
+		for typ, gr := range container.components {
+			// components aliased as interface are already scanned through their original.
+			if typ.Kind() == reflect.Interface {
+				continue
 			}
 
-			fv.Set(cdep.value.Addr())
-		} else {
-			fv.Set(cdep.value)
+			ctyp := gr[0].value.Type()
+
+			if dep.impl != "" && dep.impl != ctyp.Name() {
+				continue
+			}
+
+			// scan pointer type if value type doesn't implement the interface.
+			ptr := false
+			if !ctyp.Implements(dep.typ) {
+				if !reflect.PtrTo(ctyp).Implements(dep.typ) {
+					continue
+				}
+
+				ptr = true
+			}
+
+			if fcdep, finactive := container.lookup(gr, dep.id); fcdep == nil {
+				if finactive != nil {
+					inactive = finactive
+				}
+			} else {
+				if !visible(c, fcdep, dep.typ) {
+					private = fcdep
+					continue
+				}
+
+				ptrInterface = ptr
+				cdep = fcdep
+				matches++
+			}
 		}
 	}
 
-	c.dependencies = nil
+	if matches == 0 && private != nil {
+		return nil, false, privateError{component: *c, dependency: dep, depComponent: *private}
+	} else if matches == 0 && inactive != nil {
+		return nil, false, inactiveError{component: c, dependency: dep, depComponent: *inactive}
+	} else if matches == 0 {
+		return nil, false, dependencyNotFound{id: dep.id, component: *c, dependency: dep, skipped: container.skipped(dep)}
+	} else if matches > 1 {
+		return nil, false, ambiguousError{component: *c, dependency: dep}
+	}
+
+	return cdep, ptrInterface, nil
+}
+
+// isNotFound reports whether err is caused by missing component.
+func isNotFound(err error) bool {
+	switch err.(type) {
+	case idNotFoundError, dependencyNotFound, inactiveError:
+		return true
+	}
+
+	return false
+}
+
+// visible reports whether c is allowed to be wired with dep as typ.
+// Components of a module that exports types are private to the module, unless it's type or the requested interface is exported.
+func visible(c *component, dep *component, typ reflect.Type) bool {
+	if dep.alias != nil {
+		dep = dep.alias
+	}
+
+	return dep.module == nil || dep.module == c.module ||
+		dep.module.exported(dep.value.Type()) || dep.module.exported(typ)
+}
+
+func (container Container) caller(skip int) string {
+	_, file, no, _ := runtime.Caller(container.callerSkip + skip + 1)
+	return file + ":" + strconv.Itoa(no)
 }
@@ -148,3 +148,322 @@ func TestContainer_Resolve_valueAsPointer(t *testing.T) {
 		app.Resolve(&resolve)
 	})
 }
+
+func TestContainer_Alias(t *testing.T) {
+	componentA := ComponentA{Value1: "Hi!"}
+	componentC := ComponentC{}
+
+	app := wire.New()
+	app.Connect("LGTM!")
+	app.Connect(true)
+	app.Connect([]int{1})
+	app.Connect(&componentA, "primary")
+	app.Alias((*ComponentA)(nil), "primary", "")
+	app.Alias(ComponentA{}, "", "hello")
+	app.Connect(&ComponentB{})
+	app.Connect(&componentC)
+	app.Connect(&ComponentD{}, "component_d")
+	app.Apply()
+
+	var resolved *ComponentA
+	app.Resolve(&resolved, "hello")
+
+	assert.True(t, &componentA == resolved)
+	assert.True(t, &componentA == componentC.Value1)
+	assert.Equal(t, componentA, componentC.Value2.Value2)
+}
+
+func TestContainer_AliasAs(t *testing.T) {
+	componentA := ComponentA{Value1: "Hi!"}
+	componentE := ComponentE{}
+
+	app := wire.New()
+	app.Connect(&componentA)
+	app.Connect(&ComponentA{Value1: "Hello!"}, "hello")
+	app.AliasAs((*ComponentA)(nil), "", (*Valuer)(nil), "")
+	app.AliasAs((*ComponentA)(nil), "", (*Setter)(nil), "")
+	app.Connect(&componentE)
+	app.Apply()
+
+	var valuer Valuer
+	var setter Setter
+	app.Resolve(&valuer)
+	app.Resolve(&setter)
+
+	assert.Equal(t, componentA, componentE.Value1)
+	assert.True(t, &componentA == componentE.Value2)
+	assert.Equal(t, componentA, valuer)
+	assert.True(t, &componentA == setter)
+}
+
+func TestContainer_AliasAs_otherImplementation(t *testing.T) {
+	var component struct {
+		Valuer Valuer `wire:""`
+		Alias  Valuer `wire:"d"`
+	}
+
+	componentA := ComponentA{Value1: "Hi!"}
+	componentD := ComponentD{}
+
+	app := wire.New()
+	app.Connect("LGTM!")
+	app.Connect(componentA)
+	app.Connect(&componentD, "component_d")
+	app.AliasAs((*ComponentD)(nil), "component_d", (*Valuer)(nil), "d")
+	app.Connect(&component)
+	app.Apply()
+
+	assert.Equal(t, componentA, component.Valuer)
+	assert.Equal(t, componentD, component.Alias)
+}
+
+func TestContainer_AliasAs_notFound(t *testing.T) {
+	type Service struct {
+		Valuer Valuer `wire:"missing"`
+	}
+
+	app := wire.New()
+	app.Connect("LGTM!")
+	app.Connect(&ComponentD{}, "component_d")
+	app.AliasAs((*ComponentD)(nil), "component_d", (*Valuer)(nil), "d")
+	app.Connect(&Service{})
+
+	service := app.Components()[2]
+	assert.Equal(t, "wire_test.Service \"\"", service.String())
+	assert.Contains(t, service.Dependencies[0].Err.Error(), "requires wire_test.Valuer identified using \"missing\", but none was found")
+
+	assert.Panics(t, func() {
+		app.Apply()
+	})
+}
+
+func TestContainer_Alias_duplicate(t *testing.T) {
+	app := wire.New()
+	app.Connect(&ComponentA{})
+	app.Connect(&ComponentA{}, "hello")
+
+	assert.Panics(t, func() {
+		app.Alias((*ComponentA)(nil), "", "hello")
+	})
+}
+
+func TestContainer_Alias_notFound(t *testing.T) {
+	app := wire.New()
+
+	assert.Panics(t, func() {
+		app.Alias((*ComponentA)(nil), "", "hello")
+	})
+
+	app.Connect(&ComponentA{})
+
+	assert.Panics(t, func() {
+		app.Alias((*ComponentA)(nil), "notexist", "hello")
+	})
+}
+
+func TestContainer_AliasAs_notImplemented(t *testing.T) {
+	app := wire.New()
+	app.Connect(&ComponentB{})
+
+	assert.Panics(t, func() {
+		app.AliasAs((*ComponentB)(nil), "", (*Valuer)(nil), "")
+	})
+
+	assert.Panics(t, func() {
+		app.AliasAs((*ComponentB)(nil), "", Valuer(nil), "")
+	})
+}
+
+func TestContainer_AliasAs_notAddressable(t *testing.T) {
+	app := wire.New()
+	app.Connect(ComponentA{})
+
+	assert.Panics(t, func() {
+		app.AliasAs(ComponentA{}, "", (*Setter)(nil), "")
+	})
+}
+
+func TestContainer_Replace(t *testing.T) {
+	componentC := ComponentC{}
+	fake := ComponentA{Value1: "Fake!"}
+
+	app := wire.New()
+	app.Connect("LGTM!")
+	app.Connect(true)
+	app.Connect([]int{1})
+	app.Connect(&ComponentA{Value1: "Hi!"})
+	app.Connect(&ComponentA{Value1: "Hello!"}, "hello")
+	app.Alias((*ComponentA)(nil), "", "alias")
+	app.Connect(&ComponentB{})
+	app.Connect(&componentC)
+	app.Connect(&ComponentD{}, "component_d")
+
+	app.Replace(&fake)
+	app.Replace("Fake!")
+	app.Apply()
+
+	var resolved *ComponentA
+	app.Resolve(&resolved, "alias")
+
+	assert.True(t, &fake == componentC.Value1)
+	assert.True(t, &fake == resolved)
+	assert.Equal(t, ComponentD{Value1: "Fake!"}, componentC.Value5)
+}
+
+func TestContainer_Replace_notFound(t *testing.T) {
+	app := wire.New()
+
+	assert.Panics(t, func() {
+		app.Replace(&ComponentA{})
+	})
+
+	app.Connect(&ComponentA{})
+
+	assert.Panics(t, func() {
+		app.Replace(&ComponentA{}, "notexist")
+	})
+}
+
+func TestContainer_Replace_aliasNotAddressable(t *testing.T) {
+	app := wire.New()
+	app.Connect(&ComponentA{})
+	app.AliasAs((*ComponentA)(nil), "", (*Setter)(nil), "")
+
+	assert.Panics(t, func() {
+		app.Replace(ComponentA{})
+	})
+}
+
+func TestContainer_Clone(t *testing.T) {
+	componentA := ComponentA{Value1: "Hi!"}
+	componentE := ComponentE{}
+
+	app := wire.New()
+	app.Connect(&componentA)
+	app.Alias((*ComponentA)(nil), "", "alias")
+	app.AliasAs((*ComponentA)(nil), "", (*Setter)(nil), "")
+	app.Connect(&componentE)
+
+	clone := app.Clone()
+	clone.Replace(&ComponentA{Value1: "Clone!"})
+	clone.Apply()
+
+	var resolvedA *ComponentA
+	var resolvedE *ComponentE
+	clone.Resolve(&resolvedA, "alias")
+	clone.Resolve(&resolvedE)
+
+	assert.Equal(t, ComponentE{}, componentE)
+	assert.Equal(t, "Clone!", resolvedA.Value1)
+	assert.Equal(t, "Clone!", resolvedE.Value1.Value())
+	assert.True(t, resolvedA == resolvedE.Value2)
+}
+
+func TestContainer_Snapshot(t *testing.T) {
+	componentA := ComponentA{Value1: "Hi!"}
+
+	app := wire.New()
+	app.Connect(&componentA)
+	restore := app.Snapshot()
+
+	app.Replace(&ComponentA{Value1: "Fake!"})
+	app.Connect(true)
+	restore()
+
+	var resolvedA *ComponentA
+	app.Resolve(&resolvedA)
+	assert.True(t, &componentA == resolvedA)
+
+	assert.Panics(t, func() {
+		var resolvedBool bool
+		app.Resolve(&resolvedBool)
+	})
+}
+
+func TestContainer_Clone_shareValues(t *testing.T) {
+	componentA := ComponentA{Value1: "Hi!"}
+	componentE := ComponentE{}
+
+	app := wire.New()
+	app.Connect(&componentA)
+	app.Connect(&componentE)
+
+	clone := app.Clone(wire.ShareValues)
+	clone.Apply()
+
+	var resolvedA *ComponentA
+	clone.Resolve(&resolvedA)
+
+	assert.True(t, &componentA == resolvedA)
+	assert.True(t, &componentA == componentE.Value2)
+}
+
+func TestContainer_Merge(t *testing.T) {
+	componentA := ComponentA{Value1: "Hi!"}
+	componentE := ComponentE{}
+
+	infra := wire.New()
+	infra.Connect(&componentA)
+	infra.Alias((*ComponentA)(nil), "", "alias")
+
+	app := wire.New()
+	app.Connect(&componentE)
+	app.Merge(infra)
+	app.Apply()
+
+	var resolvedA *ComponentA
+	app.Resolve(&resolvedA, "alias")
+
+	assert.True(t, &componentA == resolvedA)
+	assert.True(t, &componentA == componentE.Value2)
+
+	// registrations of merged container stays untouched.
+	assert.Panics(t, func() {
+		var resolvedE ComponentE
+		infra.Resolve(&resolvedE)
+	})
+}
+
+func TestContainer_Merge_duplicate(t *testing.T) {
+	infra := wire.New()
+	infra.Connect(true)
+	infra.Connect(&ComponentA{})
+
+	app := wire.New()
+	app.Connect(&ComponentA{})
+
+	assert.Panics(t, func() {
+		app.Merge(infra)
+	})
+
+	// nothing is imported when merge failed.
+	assert.Panics(t, func() {
+		var resolvedBool bool
+		app.Resolve(&resolvedBool)
+	})
+}
+
+func TestContainer_Apply_suggestion(t *testing.T) {
+	type Service struct {
+		Greeting string `wire:"greting"`
+		Valuer   Valuer `wire:"componnet_a"`
+		Setter   Setter `wire:",ComponentD"`
+	}
+
+	app := wire.New()
+	app.Connect("Hello", "greeting")
+	app.Connect(&ComponentA{}, "component_a")
+	app.Connect(&Service{})
+
+	comps := app.Components()
+	service := comps[len(comps)-1]
+
+	assert.Contains(t, service.Dependencies[0].Err.Error(), "found, did you mean \"greeting\"? connected ids: \"greeting\"")
+	assert.Contains(t, service.Dependencies[1].Err.Error(), "but none was found, did you mean \"component_a\"? skipped implementations:\n"+
+		"\twire_test.ComponentA identified using \"component_a\", id doesn't match. declared at ")
+	assert.Contains(t, service.Dependencies[2].Err.Error(), "\twire_test.ComponentA identified using \"component_a\", implementation name doesn't match \"ComponentD\". declared at ")
+
+	assert.Panics(t, func() {
+		app.Apply()
+	})
+}
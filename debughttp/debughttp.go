@@ -0,0 +1,163 @@
+// Package debughttp serves the wiring of a running container over http, similar to net/http/pprof.
+//
+// The handler is usually mounted on an internal admin port:
+//
+//	http.Handle("/debug/wire/", http.StripPrefix("/debug/wire", debughttp.Handler(app)))
+//
+// Components connected using package level functions of wire are served using debughttp.Handler(wire.Global()).
+//
+// The following pages are served:
+//
+//	/              index linking to the other pages
+//	/components    html report of components and their dependencies
+//	/graph.json    dependency graph in the schema written by Container.WriteJSON
+//	/timings       html report of how long Apply took to wire each component
+//	/timings.json  the same timings as json
+//
+// Handler only reads the container, it should be mounted after wiring applied.
+// Lifecycle states are not served, since components don't have lifecycle hooks.
+package debughttp
+
+import (
+	"bytes"
+	"encoding/json"
+	"html/template"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Fs02/wire"
+)
+
+// Handler returns http handler serving the components, dependencies and timings of container.
+func Handler(container wire.Container) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, r *http.Request) {
+		serve(w, "text/html; charset=utf-8", func(w io.Writer) error {
+			return indexPage.Execute(w, nil)
+		})
+	})
+
+	mux.HandleFunc("GET /components", func(w http.ResponseWriter, r *http.Request) {
+		serve(w, "text/html; charset=utf-8", container.WriteHTML)
+	})
+
+	mux.HandleFunc("GET /graph.json", func(w http.ResponseWriter, r *http.Request) {
+		serve(w, "application/json", container.WriteJSON)
+	})
+
+	mux.HandleFunc("GET /timings", func(w http.ResponseWriter, r *http.Request) {
+		serve(w, "text/html; charset=utf-8", func(w io.Writer) error {
+			return timingsPage.Execute(w, newTimings(container.Timings()))
+		})
+	})
+
+	mux.HandleFunc("GET /timings.json", func(w http.ResponseWriter, r *http.Request) {
+		serve(w, "application/json", func(w io.Writer) error {
+			enc := json.NewEncoder(w)
+			enc.SetIndent("", "  ")
+			return enc.Encode(newTimings(container.Timings()))
+		})
+	})
+
+	return mux
+}
+
+// serve buffers the page written by write, so a failure is reported as an internal server error.
+func serve(w http.ResponseWriter, contentType string, write func(io.Writer) error) {
+	var buf bytes.Buffer
+	if err := write(&buf); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Write(buf.Bytes())
+}
+
+type timings struct {
+	Total        int64    `json:"total_ns"`
+	Components   []timing `json:"components"`
+	CriticalPath []timing `json:"critical_path"`
+}
+
+type timing struct {
+	Type       string `json:"type"`
+	ID         string `json:"id"`
+	DeclaredAt string `json:"declared_at"`
+	Duration   int64  `json:"duration_ns"`
+}
+
+func newTimings(report wire.TimingReport) timings {
+	return timings{
+		Total:        int64(report.Total),
+		Components:   newTiming(report.Components),
+		CriticalPath: newTiming(report.CriticalPath),
+	}
+}
+
+func newTiming(report []wire.Timing) []timing {
+	result := make([]timing, len(report))
+	for i, t := range report {
+		result[i] = timing{
+			Type:       t.Type.String(),
+			ID:         t.ID,
+			DeclaredAt: t.DeclaredAt,
+			Duration:   int64(t.Duration),
+		}
+	}
+
+	return result
+}
+
+var funcs = template.FuncMap{
+	"duration": func(ns int64) string {
+		return time.Duration(ns).String()
+	},
+}
+
+var indexPage = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>wire</title>
+</head>
+<body>
+<h1>wire</h1>
+<ul>
+<li><a href="components">components</a> (<a href="graph.json">json</a>)</li>
+<li><a href="timings">timings</a> (<a href="timings.json">json</a>)</li>
+</ul>
+</body>
+</html>
+`))
+
+var timingsPage = template.Must(template.New("timings").Funcs(funcs).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>wire timings</title>
+<style>
+body { font-family: Helvetica, Arial, sans-serif; margin: 2em; }
+table { border-collapse: collapse; }
+td, th { padding: 0.2em 1em 0.2em 0; text-align: left; }
+.location { color: #666; font-size: 0.9em; }
+</style>
+</head>
+<body>
+<h1>wired in {{duration .Total}}</h1>
+<h2>critical path</h2>
+<table>
+{{- range .CriticalPath}}
+<tr><td>{{.Type}} {{printf "%q" .ID}}</td><td>{{duration .Duration}}</td><td class="location">{{.DeclaredAt}}</td></tr>
+{{- end}}
+</table>
+<h2>components</h2>
+<table>
+{{- range .Components}}
+<tr><td>{{.Type}} {{printf "%q" .ID}}</td><td>{{duration .Duration}}</td><td class="location">{{.DeclaredAt}}</td></tr>
+{{- end}}
+</table>
+</body>
+</html>
+`))
@@ -0,0 +1,101 @@
+package debughttp_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Fs02/wire"
+	"github.com/Fs02/wire/debughttp"
+	"github.com/stretchr/testify/assert"
+)
+
+type Printer interface {
+	Print() string
+}
+
+type UserPrint struct {
+	Name string
+}
+
+func (up UserPrint) Print() string {
+	return up.Name
+}
+
+type Service struct {
+	Printer Printer `wire:""`
+}
+
+func handler() http.Handler {
+	app := wire.New()
+	app.Connect(UserPrint{Name: "user"})
+	app.Connect(&Service{})
+	app.Apply()
+
+	return http.StripPrefix("/debug/wire", debughttp.Handler(app))
+}
+
+func get(h http.Handler, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec
+}
+
+func TestHandler(t *testing.T) {
+	h := handler()
+
+	rec := get(h, "/debug/wire/")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `<a href="components">components</a>`)
+
+	rec = get(h, "/debug/wire/components")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Body.String(), "debughttp_test.Service")
+
+	rec = get(h, "/debug/wire/timings")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "debughttp_test.UserPrint &#34;&#34;")
+
+	assert.Equal(t, http.StatusNotFound, get(h, "/debug/wire/missing").Code)
+}
+
+func TestHandler_json(t *testing.T) {
+	h := handler()
+
+	rec := get(h, "/debug/wire/graph.json")
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	graph, err := wire.ReadGraph(rec.Body)
+	assert.Nil(t, err)
+	assert.Len(t, graph.Components, 2)
+
+	var timings struct {
+		Components []struct {
+			Type string `json:"type"`
+		} `json:"components"`
+		CriticalPath []struct {
+			Type string `json:"type"`
+		} `json:"critical_path"`
+	}
+
+	rec = get(h, "/debug/wire/timings.json")
+	assert.Nil(t, json.NewDecoder(rec.Body).Decode(&timings))
+	assert.Len(t, timings.Components, 2)
+	assert.Len(t, timings.CriticalPath, 2)
+	assert.Equal(t, "debughttp_test.Service", timings.CriticalPath[0].Type)
+}
+
+func TestHandler_global(t *testing.T) {
+	defer wire.Snapshot()()
+
+	wire.Connect(UserPrint{Name: "global"}, "global")
+	wire.Apply()
+
+	h := http.StripPrefix("/debug/wire", debughttp.Handler(wire.Global()))
+
+	rec := get(h, "/debug/wire/graph.json")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"key": "debughttp_test.UserPrint \"global\""`)
+}
@@ -0,0 +1,84 @@
+package wire
+
+import (
+	"reflect"
+)
+
+type decorator struct {
+	fn         reflect.Value
+	id         string
+	hasID      bool
+	declaredAt string
+}
+
+// Decorate components with fn before they are wired or resolved, optionally only components identified by id.
+// fn must be a function in form of func(T) T, for example func(Printer) Printer,
+// it's called once for every component wired as T with the fully wired component.
+// An alias shares the decorated value of it's original component, decorator identified by id matches the original id.
+// Decorators of the same type are called in the order they are registered, the first decorator wraps the component itself.
+func (container Container) Decorate(fn interface{}, id ...string) {
+	rv := reflect.ValueOf(fn)
+	rt := reflect.TypeOf(fn)
+
+	if rt == nil || rt.Kind() != reflect.Func || rt.NumIn() != 1 || rt.NumOut() != 1 || rt.In(0) != rt.Out(0) {
+		panic(decoratorParamError{fnType: rt})
+	}
+
+	dec := &decorator{
+		fn:         rv,
+		declaredAt: container.caller(1),
+	}
+
+	if len(id) > 0 {
+		dec.id = id[0]
+		dec.hasID = true
+	}
+
+	typ := rt.In(0)
+	container.decorators[typ] = append(container.decorators[typ], dec)
+}
+
+// decorate val of component c that is going to be wired as typ.
+// Decorated value is cached on the original component, so every field and resolve gets the same decorated value
+// whether it's wired through an alias or not, concurrent resolves wait for the decorators to be called once.
+func (container Container) decorate(c *component, typ reflect.Type, val reflect.Value) reflect.Value {
+	decs := container.decorators[typ]
+	if len(decs) == 0 {
+		return val
+	}
+
+	if c.alias != nil {
+		c = c.alias
+	}
+
+	c.decorating.Lock()
+	defer c.decorating.Unlock()
+
+	if dv, ok := c.decorated[typ]; ok {
+		return dv
+	}
+
+	var chain []*decorator
+	for _, dec := range decs {
+		if dec.hasID && dec.id != c.id {
+			continue
+		}
+
+		chain = append(chain, dec)
+		val = dec.fn.Call([]reflect.Value{val})[0]
+
+		switch val.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+			if val.IsNil() {
+				panic(decoratorNilError{component: *c, decoratorType: typ, chain: chain})
+			}
+		}
+	}
+
+	if c.decorated == nil {
+		c.decorated = make(map[reflect.Type]reflect.Value)
+	}
+
+	c.decorated[typ] = val
+	return val
+}
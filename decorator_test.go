@@ -0,0 +1,156 @@
+package wire_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Fs02/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+type prefixValuer struct {
+	prefix string
+	inner  Valuer
+}
+
+func (pv prefixValuer) Value() string {
+	return pv.prefix + pv.inner.Value()
+}
+
+func TestContainer_Decorate(t *testing.T) {
+	calls := 0
+	componentE := ComponentE{}
+	componentC := ComponentC{}
+
+	app := wire.New()
+	app.Connect("LGTM!")
+	app.Connect(true)
+	app.Connect([]int{1})
+	app.Connect(&ComponentA{Value1: "Hi!"})
+	app.Connect(&ComponentA{Value1: "Hello!"}, "hello")
+	app.Connect(&ComponentB{})
+	app.Connect(&componentC)
+	app.Connect(&ComponentD{}, "component_d")
+	app.Connect(&componentE)
+	app.Decorate(func(v Valuer) Valuer {
+		calls++
+		return prefixValuer{prefix: "1:", inner: v}
+	})
+	app.Decorate(func(v Valuer) Valuer {
+		return prefixValuer{prefix: "2:", inner: v}
+	})
+	app.Decorate(func(v Valuer) Valuer {
+		return prefixValuer{prefix: "D:", inner: v}
+	}, "component_d")
+	app.Decorate(func(a *ComponentA) *ComponentA {
+		return &ComponentA{Value1: "Decorated " + a.Value1}
+	})
+	app.Apply()
+
+	var resolved *ComponentA
+	app.Resolve(&resolved)
+
+	assert.Equal(t, "2:1:Hi!", componentE.Value1.Value())
+	assert.Equal(t, "D:2:1:LGTM!", componentC.Value5.Value())
+	assert.Equal(t, "Decorated Hi!", componentC.Value1.Value1)
+	assert.Equal(t, "Decorated Hi!", resolved.Value1)
+	assert.True(t, resolved == componentC.Value1)
+	assert.Equal(t, 2, calls)
+}
+
+func TestContainer_Decorate_resolveInterface(t *testing.T) {
+	app := wire.New()
+	app.Connect(&ComponentA{Value1: "Hi!"})
+	app.AliasAs((*ComponentA)(nil), "", (*Valuer)(nil), "")
+	app.Decorate(func(v Valuer) Valuer {
+		return prefixValuer{prefix: "1:", inner: v}
+	})
+	app.Apply()
+
+	var valuer Valuer
+	app.Resolve(&valuer)
+
+	assert.Equal(t, "1:Hi!", valuer.Value())
+}
+
+func TestContainer_Decorate_alias(t *testing.T) {
+	var (
+		calls      int
+		componentE ComponentE
+	)
+
+	app := wire.New()
+	app.Connect(&ComponentA{Value1: "Hi!"})
+	app.AliasAs((*ComponentA)(nil), "", (*Valuer)(nil), "valuer")
+	app.Connect(&componentE)
+	app.Decorate(func(v Valuer) Valuer {
+		calls++
+		return prefixValuer{prefix: "1:", inner: v}
+	})
+	app.Apply()
+
+	var valuer Valuer
+	app.Resolve(&valuer, "valuer")
+
+	assert.Equal(t, "1:Hi!", valuer.Value())
+	assert.True(t, valuer == componentE.Value1)
+	assert.Equal(t, 1, calls)
+}
+
+func TestContainer_Decorate_concurrentResolve(t *testing.T) {
+	var calls int32
+
+	app := wire.New()
+	app.Connect(&ComponentA{Value1: "Hi!"})
+	app.AliasAs((*ComponentA)(nil), "", (*Valuer)(nil), "")
+	app.Decorate(func(v Valuer) Valuer {
+		atomic.AddInt32(&calls, 1)
+		return prefixValuer{prefix: "1:", inner: v}
+	})
+	app.Apply()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var valuer Valuer
+			app.Resolve(&valuer)
+			assert.Equal(t, "1:Hi!", valuer.Value())
+		}()
+	}
+
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestContainer_Decorate_invalid(t *testing.T) {
+	app := wire.New()
+
+	assert.Panics(t, func() {
+		app.Decorate(nil)
+	})
+
+	assert.Panics(t, func() {
+		app.Decorate(func(v Valuer) {})
+	})
+
+	assert.Panics(t, func() {
+		app.Decorate(func(v Valuer) *ComponentA { return nil })
+	})
+}
+
+func TestContainer_Decorate_nil(t *testing.T) {
+	app := wire.New()
+	app.Connect(&ComponentA{})
+	app.Connect(&ComponentE{})
+	app.Decorate(func(v Valuer) Valuer {
+		return nil
+	})
+
+	assert.Panics(t, func() {
+		app.Apply()
+	})
+}
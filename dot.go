@@ -0,0 +1,134 @@
+package wire
+
+import (
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// WriteDOT writes the dependency graph of container in graphviz DOT format.
+// Components are rendered as nodes and wired fields as labelled edges,
+// dependency that can't be resolved is highlighted, so it's safe to be called before a failing Apply.
+func (container Container) WriteDOT(w io.Writer) error {
+	comps := container.Components()
+	ids := nodeIDs(comps)
+
+	var b strings.Builder
+	b.WriteString("digraph wire {\n")
+	b.WriteString("\trankdir=LR;\n")
+	b.WriteString("\tnode [shape=box, fontname=\"Helvetica\"];\n")
+	b.WriteString("\tedge [fontname=\"Helvetica\", fontsize=10];\n")
+
+	// group components of each module into a cluster.
+	var modules []string
+	grouped := make(map[string][]*Component)
+	for _, c := range comps {
+		if _, ok := grouped[c.Module]; !ok {
+			modules = append(modules, c.Module)
+		}
+
+		grouped[c.Module] = append(grouped[c.Module], c)
+	}
+
+	for i, mod := range modules {
+		indent := "\t"
+		if mod != "" {
+			b.WriteString("\tsubgraph cluster_" + strconv.Itoa(i) + " {\n")
+			b.WriteString("\t\tlabel=" + dotQuote("module "+mod) + ";\n")
+			indent = "\t\t"
+		}
+
+		for _, c := range grouped[mod] {
+			b.WriteString(indent + ids[c] + " [label=" + dotQuote(nodeLabel(c)) + dotNodeStyle(c) + "];\n")
+		}
+
+		if mod != "" {
+			b.WriteString("\t}\n")
+		}
+	}
+
+	for _, c := range comps {
+		if c.AliasOf != nil {
+			b.WriteString("\t" + ids[c] + " -> " + ids[c.AliasOf] + " [label=\"alias\", style=dashed];\n")
+		}
+
+		for j, dep := range c.Dependencies {
+			switch {
+			case dep.Component != nil:
+				b.WriteString("\t" + ids[c] + " -> " + ids[dep.Component] + " [label=" + dotQuote(edgeLabel(dep)) + "];\n")
+			case dep.Err != nil:
+				missing := ids[c] + "_" + strconv.Itoa(j)
+				b.WriteString("\t" + missing + " [label=" + dotQuote(errorKind(dep.Err)+"\n"+dep.Type.String()+" "+strconv.Quote(dep.ID)) +
+					", color=red, fontcolor=red, style=dashed, tooltip=" + dotQuote(dep.Err.Error()) + "];\n")
+				b.WriteString("\t" + ids[c] + " -> " + missing + " [label=" + dotQuote(edgeLabel(dep)) + ", color=red, fontcolor=red];\n")
+			}
+		}
+	}
+
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func dotNodeStyle(c *Component) string {
+	switch {
+	case !c.Active:
+		return ", style=dashed, color=gray, fontcolor=gray"
+	case c.AliasOf != nil:
+		return ", style=rounded"
+	case c.Config:
+		return ", shape=note"
+	}
+
+	return ""
+}
+
+// dotQuote quotes s as DOT string, newline is rendered as a centered line break.
+func dotQuote(s string) string {
+	s = strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "\n", "\\n").Replace(s)
+	return "\"" + s + "\""
+}
+
+func nodeIDs(comps []*Component) map[*Component]string {
+	ids := make(map[*Component]string, len(comps))
+	for i, c := range comps {
+		ids[c] = "c" + strconv.Itoa(i)
+	}
+
+	return ids
+}
+
+// nodeLabel describes component with it's type, id and short declaration site.
+func nodeLabel(c *Component) string {
+	label := c.Type.String()
+	if c.ID != "" {
+		label += "\n" + strconv.Quote(c.ID)
+	}
+
+	if len(c.Profiles) != 0 {
+		label += "\nprofile " + strings.Join(c.Profiles, ", ")
+	}
+
+	return label + "\n" + shortLocation(c.DeclaredAt)
+}
+
+// edgeLabel describes dependency with it's field name and decorators.
+func edgeLabel(dep Dependency) string {
+	label := dep.Field
+	if dep.Impl != "" {
+		label += " (" + dep.Impl + ")"
+	}
+
+	if len(dep.Decorators) != 0 {
+		label += "\ndecorated " + strconv.Itoa(len(dep.Decorators)) + "x"
+	}
+
+	return label
+}
+
+// shortLocation strips directories of the declaration site.
+func shortLocation(declaredAt string) string {
+	return filepath.Base(declaredAt)
+}
@@ -0,0 +1,41 @@
+package wire_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/Fs02/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+type errWriter struct{}
+
+func (errWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write error")
+}
+
+func TestContainer_WriteDOT(t *testing.T) {
+	app := graphContainer()
+
+	var buf bytes.Buffer
+	assert.Nil(t, app.WriteDOT(&buf))
+
+	dot := buf.String()
+	assert.Contains(t, dot, "digraph wire {\n")
+	assert.Contains(t, dot, "\tsubgraph cluster_1 {\n\t\tlabel=\"module infra\";\n\t\tc1 [label=\"wire_test.ComponentA\\nwire_test.go:")
+	assert.Contains(t, dot, "\tc0 [label=\"string\\nprofile prod\\nwire_test.go:")
+	assert.Contains(t, dot, "\", style=dashed, color=gray, fontcolor=gray];\n")
+	assert.Contains(t, dot, "\tc2 [label=\"wire_test.ComponentA\\n\\\"alias\\\"\\nwire_test.go:")
+	assert.Contains(t, dot, "\tc2 -> c1 [label=\"alias\", style=dashed];\n")
+	assert.Contains(t, dot, "\tc4 -> c1 [label=\"Value1\\ndecorated 1x\"];\n")
+	assert.Contains(t, dot, "\tc4 -> c1 [label=\"Value2\"];\n")
+	assert.Contains(t, dot, "\tc3_0 [label=\"inactive\\nstring \\\"\\\"\", color=red, fontcolor=red, style=dashed, tooltip=\"wire: field Value1")
+	assert.Contains(t, dot, "\tc3 -> c3_0 [label=\"Value1 (ComponentA)\", color=red, fontcolor=red];\n")
+	assert.Contains(t, dot, "}\n")
+}
+
+func TestContainer_WriteDOT_writeError(t *testing.T) {
+	app := wire.New()
+	assert.NotNil(t, app.WriteDOT(errWriter{}))
+}
@@ -1,26 +1,36 @@
 package wire
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
 )
 
 type idNotFoundError struct {
 	id        string
-	component component
+	paramType reflect.Type
+	ids       []string
 }
 
 func (err idNotFoundError) Error() string {
-	return "wire: no " + err.component.value.Type().String() +
-		" identified using \"" + err.id + "\" found"
+	return "wire: no " + err.paramType.String() +
+		" identified using \"" + err.id + "\" found" + suggest(err.id, err.ids)
 }
 
 type duplicateError struct {
 	previous component
+	current  component
 }
 
 func (err duplicateError) Error() string {
-	return "wire: trying to connect component with same type and id. previosly declared here:\n\t" +
-		err.previous.declaredAt
+	msg := "wire: trying to connect component with same type and id. previosly declared here:\n\t" +
+		err.previous.location()
+
+	if err.current.declaredAt != "" {
+		msg += "\n\t" + err.current.location()
+	}
+
+	return msg
 }
 
 type tagMissingError struct {
@@ -63,7 +73,7 @@ type notAddressableError struct {
 func (err notAddressableError) Error() string {
 	return "wire: component with type " + err.paramType.String() + " identified by \"" + err.id +
 		"\" is not addressable, connect component using reference instead of value. declared here:\n\t" +
-		err.component.declaredAt
+		err.component.location()
 }
 
 type typeNotFoundError struct {
@@ -78,12 +88,43 @@ type dependencyNotFound struct {
 	id         string
 	component  component
 	dependency dependency
+	skipped    []skippedImplementation
 }
 
 func (err dependencyNotFound) Error() string {
-	return "wire: field " + err.dependency.name + " of " + err.component.value.Type().String() +
-		" requires " + err.dependency.typ.String() + " identified using \"" + err.id +
-		"\", but none was found. declared here:\n\t" + err.component.declaredAt
+	msg := "wire: field " + err.dependency.name + " of " + err.component.value.Type().String() +
+		" requires " + err.dependency.typ.String() + " identified using \"" + err.id + "\", but none was found"
+
+	var ids []string
+	for _, s := range err.skipped {
+		if s.idMismatch {
+			ids = append(ids, s.component.id)
+		}
+	}
+
+	if closest := closest(err.id, uniqueSorted(ids)); len(closest) != 0 {
+		msg += ", did you mean " + quoteAll(closest, " or ") + "?"
+	} else {
+		msg += "."
+	}
+
+	if len(err.skipped) == 0 {
+		return msg + " declared here:\n\t" + err.component.location()
+	}
+
+	msg += " skipped implementations:"
+	for _, s := range err.skipped {
+		msg += "\n\t" + s.component.value.Type().String() + " identified using \"" + s.component.id + "\""
+		if s.idMismatch {
+			msg += ", id doesn't match"
+		} else {
+			msg += ", implementation name doesn't match \"" + err.dependency.impl + "\""
+		}
+
+		msg += ". declared at " + s.component.declaredAt
+	}
+
+	return msg + "\ndeclared here:\n\t" + err.component.location()
 }
 
 type ambiguousError struct {
@@ -94,7 +135,7 @@ type ambiguousError struct {
 func (err ambiguousError) Error() string {
 	return "wire: ambiguous connection found on field " + err.dependency.name + " of " +
 		err.component.value.Type().String() + ", multiple components satisfy " + err.dependency.typ.String() +
-		" interface, consider using id. declared here:\n\t" + err.component.declaredAt
+		" interface, consider using id. declared here:\n\t" + err.component.location()
 }
 
 type requiresPointerError struct {
@@ -106,6 +147,194 @@ type requiresPointerError struct {
 func (err requiresPointerError) Error() string {
 	return "wire: field " + err.dependency.name + " of " + err.component.value.Type().String() +
 		" requires " + err.dependency.typ.String() + " as pointer, connect " + err.dependency.typ.String() +
-		" as a reference instead of a value. declared here:\n\t" + err.component.declaredAt +
-		"\n\t" + err.depComponent.declaredAt
+		" as a reference instead of a value. declared here:\n\t" + err.component.location() +
+		"\n\t" + err.depComponent.location()
+}
+
+type aliasParamError struct{}
+
+func (err aliasParamError) Error() string {
+	return "wire: alias type must be a pointer to an interface, for example (*Printer)(nil)"
+}
+
+type aliasTypeError struct {
+	aliasType reflect.Type
+	component component
+}
+
+func (err aliasTypeError) Error() string {
+	return "wire: cannot alias " + err.component.value.Type().String() + " identified by \"" + err.component.id +
+		"\" as " + err.aliasType.String() + ", it doesn't implement the interface. declared here:\n\t" +
+		err.component.location()
+}
+
+type moduleInstalledError struct {
+	module   *Module
+	previous string
+}
+
+func (err moduleInstalledError) Error() string {
+	return "wire: module \"" + err.module.name + "\" is already installed. previously installed here:\n\t" +
+		err.previous
+}
+
+type privateError struct {
+	component    component
+	dependency   dependency
+	depComponent component
+}
+
+func (err privateError) Error() string {
+	owner := err.depComponent
+	if owner.alias != nil {
+		owner = *owner.alias
+	}
+
+	return "wire: field " + err.dependency.name + " of " + err.component.value.Type().String() + " in " +
+		moduleName(err.component.module) + " requires " + err.dependency.typ.String() + ", but " +
+		err.depComponent.value.Type().String() + " identified using \"" + err.depComponent.id + "\" is private to " +
+		moduleName(owner.module) + ", consider exporting it. declared here:\n\t" + err.component.location() +
+		"\n\t" + err.depComponent.location()
+}
+
+type inactiveError struct {
+	component    *component
+	dependency   dependency
+	depComponent component
+}
+
+func (err inactiveError) Error() string {
+	msg := "wire: "
+	if err.component != nil {
+		msg += "field " + err.dependency.name + " of " + err.component.value.Type().String() + " requires " +
+			err.dependency.typ.String() + ", but "
+	}
+
+	msg += err.depComponent.value.Type().String() + " identified using \"" + err.depComponent.id + "\" is "
+	if err.depComponent.disabled {
+		msg += "not connected because it's condition is false"
+	} else {
+		msg += "only connected for inactive profile \"" + strings.Join(err.depComponent.profiles, "\", \"") + "\""
+	}
+
+	msg += ". declared here:\n\t"
+	if err.component != nil {
+		msg += err.component.location() + "\n\t"
+	}
+
+	return msg + err.depComponent.location()
+}
+
+type configNotFoundError struct {
+	component  component
+	dependency dependency
+}
+
+func (err configNotFoundError) Error() string {
+	return "wire: field " + err.dependency.name + " of " + err.component.value.Type().String() +
+		" requires config \"" + err.dependency.config + "\", but none was found in config sources. declared here:\n\t" +
+		err.component.location()
+}
+
+type configConvertError struct {
+	component  component
+	dependency dependency
+	value      interface{}
+	err        error
+}
+
+func (err configConvertError) Error() string {
+	msg := "wire: cannot convert default value"
+	if err.dependency.config != "" {
+		msg = "wire: cannot convert config \"" + err.dependency.config + "\" with value"
+	}
+
+	return msg + " " + fmt.Sprintf("%#v", err.value) + " for field " + err.dependency.name + " of " + err.component.value.Type().String() + ": " + err.err.Error() +
+		". declared here:\n\t" + err.component.location()
+}
+
+type configParamError struct {
+	paramType reflect.Type
+}
+
+func (err configParamError) Error() string {
+	return "wire: config must be a struct, got " + err.paramType.String()
+}
+
+type requiredError struct {
+	component  component
+	dependency dependency
+}
+
+func (err requiredError) Error() string {
+	msg := "wire: field " + err.dependency.name + " of " + err.component.value.Type().String() + " is required"
+	if err.dependency.config != "" {
+		msg += ", but config \"" + err.dependency.config + "\" is empty or not found"
+	} else {
+		msg += ", but it's empty after wiring"
+	}
+
+	return msg + ". declared here:\n\t" + err.component.location()
+}
+
+type decoratorParamError struct {
+	fnType reflect.Type
+}
+
+func (err decoratorParamError) Error() string {
+	typ := "nil"
+	if err.fnType != nil {
+		typ = err.fnType.String()
+	}
+
+	return "wire: decorator must be a function in form of func(T) T, got " + typ
+}
+
+type decoratorNilError struct {
+	component     component
+	decoratorType reflect.Type
+	chain         []*decorator
+}
+
+func (err decoratorNilError) Error() string {
+	msg := "wire: decorator of " + err.decoratorType.String() + " returned nil for component identified using \"" +
+		err.component.id + "\". decoration chain:"
+
+	for _, dec := range err.chain {
+		msg += "\n\t" + dec.fn.Type().String() + " declared at " + dec.declaredAt
+	}
+
+	return msg + "\ndeclared here:\n\t" + err.component.location()
+}
+
+type graphRecordError struct {
+	path string
+	err  error
+}
+
+func (err graphRecordError) Error() string {
+	return "wire: cannot record graph to " + err.path + ": " + err.err.Error()
+}
+
+type fieldNotFoundError struct {
+	component component
+	field     string
+}
+
+func (err fieldNotFoundError) Error() string {
+	return "wire: " + err.component.value.Type().String() + " has no field " + err.field +
+		" to be wired. declared here:\n\t" + err.component.location()
+}
+
+type unusedError struct {
+	components []*component
+}
+
+func (err unusedError) Error() string {
+	msg := "wire: components are connected but never wired or resolved, consider removing them. declared here:"
+	for _, c := range err.components {
+		msg += "\n\t" + c.value.Type().String() + " identified using \"" + c.id + "\" at " + c.location()
+	}
+
+	return msg
 }
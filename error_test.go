@@ -1,6 +1,7 @@
 package wire
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -24,7 +25,11 @@ func getDependency() dependency {
 
 func TestIdNotFoundError(t *testing.T) {
 	assert.Equal(t, "wire: no int identified using \"a\" found",
-		idNotFoundError{id: "a", component: getComponent()}.Error())
+		idNotFoundError{id: "a", paramType: reflect.TypeOf(0)}.Error())
+	assert.Equal(t, "wire: no int identified using \"user\" found, did you mean \"users\"? connected ids: \"\", \"orders\", \"users\"",
+		idNotFoundError{id: "user", paramType: reflect.TypeOf(0), ids: []string{"", "orders", "users"}}.Error())
+	assert.Equal(t, "wire: no int identified using \"cache\" found. connected ids: \"orders\"",
+		idNotFoundError{id: "cache", paramType: reflect.TypeOf(0), ids: []string{"orders"}}.Error())
 }
 
 func TestDuplicateError(t *testing.T) {
@@ -65,6 +70,38 @@ func TestTypeNotFoundError(t *testing.T) {
 func TestDependencyNotFoundError(t *testing.T) {
 	assert.Equal(t, "wire: field A of int requires int identified using \"a\", but none was found. declared here:\n\t/somefile.go:1",
 		dependencyNotFound{id: "a", component: getComponent(), dependency: getDependency()}.Error())
+
+	dep := getDependency()
+	dep.impl = "Console"
+	skippedID := getComponent()
+	skippedID.id = "b"
+	skippedImpl := component{value: reflect.ValueOf(""), declaredAt: "/otherfile.go:2"}
+
+	assert.Equal(t, "wire: field A of int requires int identified using \"a\", but none was found, did you mean \"b\"? skipped implementations:\n"+
+		"\tint identified using \"b\", id doesn't match. declared at /somefile.go:1\n"+
+		"\tstring identified using \"\", implementation name doesn't match \"Console\". declared at /otherfile.go:2\n"+
+		"declared here:\n\t/somefile.go:1",
+		dependencyNotFound{id: "a", component: getComponent(), dependency: dep, skipped: []skippedImplementation{
+			{component: skippedID, idMismatch: true},
+			{component: skippedImpl},
+		}}.Error())
+}
+
+func TestClosest(t *testing.T) {
+	ids := []string{"", "user", "users", "orders", "admin"}
+
+	assert.Equal(t, []string{"users"}, closest("userss", ids))
+	assert.Equal(t, []string{"user", "users"}, closest("usera", ids))
+	assert.Equal(t, []string{"orders"}, closest("order", ids))
+	assert.Nil(t, closest("cache", ids))
+	assert.Equal(t, []string{""}, closest("a", ids))
+}
+
+func TestDistance(t *testing.T) {
+	assert.Equal(t, 0, distance("", ""))
+	assert.Equal(t, 3, distance("", "abc"))
+	assert.Equal(t, 3, distance("kitten", "sitting"))
+	assert.Equal(t, 1, distance("héllo", "hello"))
 }
 
 func TestAmbiguousError(t *testing.T) {
@@ -76,3 +113,144 @@ func TestRequiresPointerError(t *testing.T) {
 	assert.Equal(t, "wire: field A of int requires int as pointer, connect int as a reference instead of a value. declared here:\n\t/somefile.go:1\n\t/somefile.go:1",
 		requiresPointerError{component: getComponent(), dependency: getDependency(), depComponent: getComponent()}.Error())
 }
+
+func TestAliasParamError(t *testing.T) {
+	assert.Equal(t, "wire: alias type must be a pointer to an interface, for example (*Printer)(nil)",
+		aliasParamError{}.Error())
+}
+
+func TestAliasTypeError(t *testing.T) {
+	assert.Equal(t, "wire: cannot alias int identified by \"\" as error, it doesn't implement the interface. declared here:\n\t/somefile.go:1",
+		aliasTypeError{aliasType: reflect.TypeOf((*error)(nil)).Elem(), component: getComponent()}.Error())
+}
+
+func TestComponent_location_alias(t *testing.T) {
+	original := getComponent()
+	original.id = "a"
+	alias := component{value: original.value, declaredAt: "/otherfile.go:2", alias: &original}
+
+	assert.Equal(t, "wire: trying to connect component with same type and id. previosly declared here:\n\t/otherfile.go:2 (alias of \"a\" declared at /somefile.go:1)",
+		duplicateError{previous: alias}.Error())
+}
+
+func TestComponent_location_replaced(t *testing.T) {
+	comp := getComponent()
+	comp.replaced = "/otherfile.go:2"
+
+	assert.Equal(t, "wire: trying to connect component with same type and id. previosly declared here:\n\t/somefile.go:1 (replaces /otherfile.go:2)",
+		duplicateError{previous: comp}.Error())
+}
+
+func TestDuplicateError_current(t *testing.T) {
+	current := getComponent()
+	current.declaredAt = "/otherfile.go:2"
+
+	assert.Equal(t, "wire: trying to connect component with same type and id. previosly declared here:\n\t/somefile.go:1\n\t/otherfile.go:2",
+		duplicateError{previous: getComponent(), current: current}.Error())
+}
+
+func TestModuleInstalledError(t *testing.T) {
+	assert.Equal(t, "wire: module \"a\" is already installed. previously installed here:\n\t/somefile.go:1",
+		moduleInstalledError{module: &Module{name: "a"}, previous: "/somefile.go:1"}.Error())
+}
+
+func TestComponent_location_module(t *testing.T) {
+	comp := getComponent()
+	comp.module = &Module{name: "a"}
+
+	assert.Equal(t, "wire: trying to connect component with same type and id. previosly declared here:\n\t/somefile.go:1 in module \"a\"",
+		duplicateError{previous: comp}.Error())
+}
+
+func TestPrivateError(t *testing.T) {
+	depComponent := getComponent()
+	depComponent.module = &Module{name: "b"}
+
+	assert.Equal(t, "wire: field A of int in no module requires int, but int identified using \"\" is private to module \"b\", consider exporting it. declared here:\n\t/somefile.go:1\n\t/somefile.go:1 in module \"b\"",
+		privateError{component: getComponent(), dependency: getDependency(), depComponent: depComponent}.Error())
+}
+
+func TestInactiveError(t *testing.T) {
+	comp := getComponent()
+	depComponent := getComponent()
+	depComponent.profiles = []string{"dev", "prod"}
+
+	assert.Equal(t, "wire: int identified using \"\" is only connected for inactive profile \"dev\", \"prod\". declared here:\n\t/somefile.go:1",
+		inactiveError{depComponent: depComponent}.Error())
+
+	depComponent.disabled = true
+	assert.Equal(t, "wire: field A of int requires int, but int identified using \"\" is not connected because it's condition is false. declared here:\n\t/somefile.go:1\n\t/somefile.go:1",
+		inactiveError{component: &comp, dependency: getDependency(), depComponent: depComponent}.Error())
+}
+
+func TestConfigNotFoundError(t *testing.T) {
+	dep := getDependency()
+	dep.config = "db.port"
+
+	assert.Equal(t, "wire: field A of int requires config \"db.port\", but none was found in config sources. declared here:\n\t/somefile.go:1",
+		configNotFoundError{component: getComponent(), dependency: dep}.Error())
+}
+
+func TestConfigConvertError(t *testing.T) {
+	dep := getDependency()
+	dep.config = "db.port"
+
+	assert.Equal(t, "wire: cannot convert config \"db.port\" with value \"abc\" for field A of int: invalid syntax. declared here:\n\t/somefile.go:1",
+		configConvertError{component: getComponent(), dependency: dep, value: "abc", err: errors.New("invalid syntax")}.Error())
+}
+
+func TestConfigParamError(t *testing.T) {
+	assert.Equal(t, "wire: config must be a struct, got int",
+		configParamError{paramType: reflect.TypeOf(0)}.Error())
+}
+
+func TestRequiredError(t *testing.T) {
+	dep := getDependency()
+	assert.Equal(t, "wire: field A of int is required, but it's empty after wiring. declared here:\n\t/somefile.go:1",
+		requiredError{component: getComponent(), dependency: dep}.Error())
+
+	dep.config = "db.port"
+	assert.Equal(t, "wire: field A of int is required, but config \"db.port\" is empty or not found. declared here:\n\t/somefile.go:1",
+		requiredError{component: getComponent(), dependency: dep}.Error())
+}
+
+func TestConfigConvertError_default(t *testing.T) {
+	assert.Equal(t, "wire: cannot convert default value \"abc\" for field A of int: invalid syntax. declared here:\n\t/somefile.go:1",
+		configConvertError{component: getComponent(), dependency: getDependency(), value: "abc", err: errors.New("invalid syntax")}.Error())
+}
+
+func TestDecoratorParamError(t *testing.T) {
+	assert.Equal(t, "wire: decorator must be a function in form of func(T) T, got nil",
+		decoratorParamError{}.Error())
+	assert.Equal(t, "wire: decorator must be a function in form of func(T) T, got int",
+		decoratorParamError{fnType: reflect.TypeOf(0)}.Error())
+}
+
+func TestDecoratorNilError(t *testing.T) {
+	fn := func(i int) int { return i }
+	chain := []*decorator{{fn: reflect.ValueOf(fn), declaredAt: "/decorator.go:1"}, {fn: reflect.ValueOf(fn), declaredAt: "/decorator.go:2"}}
+
+	assert.Equal(t, "wire: decorator of int returned nil for component identified using \"\". decoration chain:\n\tfunc(int) int declared at /decorator.go:1\n\tfunc(int) int declared at /decorator.go:2\ndeclared here:\n\t/somefile.go:1",
+		decoratorNilError{component: getComponent(), decoratorType: reflect.TypeOf(0), chain: chain}.Error())
+}
+
+func TestGraphRecordError(t *testing.T) {
+	assert.Equal(t, "wire: cannot record graph to /wire.json: permission denied",
+		graphRecordError{path: "/wire.json", err: errors.New("permission denied")}.Error())
+}
+
+func TestFieldNotFoundError(t *testing.T) {
+	assert.Equal(t, "wire: int has no field A to be wired. declared here:\n\t/somefile.go:1",
+		fieldNotFoundError{component: getComponent(), field: "A"}.Error())
+}
+
+func TestUnusedError(t *testing.T) {
+	comp := getComponent()
+	other := getComponent()
+	other.id = "a"
+	other.declaredAt = "/otherfile.go:2"
+
+	assert.Equal(t, "wire: components are connected but never wired or resolved, consider removing them. declared here:\n"+
+		"\tint identified using \"\" at /somefile.go:1\n\tint identified using \"a\" at /otherfile.go:2",
+		unusedError{components: []*component{&comp, &other}}.Error())
+}
@@ -0,0 +1,110 @@
+package wire
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// EventKind identifies the activity of a container reported to listeners.
+type EventKind int
+
+const (
+	// Connected is emitted when a component is connected, aliased or merged from another container.
+	Connected EventKind = iota + 1
+	// Replaced is emitted when a component is replaced.
+	Replaced
+	// DependencyResolved is emitted when a field is wired with a component.
+	DependencyResolved
+	// ApplyStarted is emitted before wiring applied.
+	ApplyStarted
+	// ApplyFinished is emitted after wiring applied, or failed with Err.
+	ApplyFinished
+	// ResolveCalled is emitted when a component is resolved.
+	ResolveCalled
+)
+
+// String returns the name of the event kind.
+func (kind EventKind) String() string {
+	switch kind {
+	case Connected:
+		return "connected"
+	case Replaced:
+		return "replaced"
+	case DependencyResolved:
+		return "dependency resolved"
+	case ApplyStarted:
+		return "apply started"
+	case ApplyFinished:
+		return "apply finished"
+	case ResolveCalled:
+		return "resolve called"
+	}
+
+	return "unknown"
+}
+
+// Event describes an activity of a container.
+// Type, ID and DeclaredAt describe the component involved, Field and Dependency are only set for DependencyResolved,
+// Duration and Err are only set for ApplyFinished.
+type Event struct {
+	Kind         EventKind
+	Type         reflect.Type
+	ID           string
+	DeclaredAt   string
+	Field        string
+	Dependency   reflect.Type
+	DependencyID string
+	Duration     time.Duration
+	Err          error
+}
+
+// Listener is called synchronously for every event of the container it's registered to.
+type Listener func(Event)
+
+// Listen registers fn to be called for events of container, listeners are called in the order they are registered.
+func (container Container) Listen(fn Listener) {
+	*container.listeners = append(*container.listeners, fn)
+}
+
+func (container Container) emit(event Event) {
+	for _, fn := range *container.listeners {
+		fn(event)
+	}
+}
+
+func (container Container) emitComponent(kind EventKind, c *component) {
+	if len(*container.listeners) == 0 {
+		return
+	}
+
+	container.emit(Event{
+		Kind:       kind,
+		Type:       c.value.Type(),
+		ID:         c.id,
+		DeclaredAt: c.declaredAt,
+	})
+}
+
+// finished emits ApplyFinished, a panic is reported as Err before it's propagated.
+func (container Container) finished(start time.Time) {
+	if len(*container.listeners) == 0 {
+		return
+	}
+
+	r := recover()
+	event := Event{Kind: ApplyFinished, Duration: time.Since(start)}
+	if r != nil {
+		if err, ok := r.(error); ok {
+			event.Err = err
+		} else {
+			event.Err = fmt.Errorf("%v", r)
+		}
+	}
+
+	container.emit(event)
+
+	if r != nil {
+		panic(r)
+	}
+}
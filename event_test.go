@@ -0,0 +1,98 @@
+package wire_test
+
+import (
+	"bytes"
+	"log/slog"
+	"reflect"
+	"testing"
+
+	"github.com/Fs02/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainer_Listen(t *testing.T) {
+	var events []wire.Event
+
+	app := wire.New()
+	app.Listen(func(event wire.Event) {
+		events = append(events, event)
+	})
+
+	app.Connect(&ComponentA{Value1: "Hi!"})
+	app.Connect(&ComponentE{})
+	app.Apply()
+
+	var out ComponentE
+	app.Resolve(&out)
+
+	kinds := make([]wire.EventKind, len(events))
+	for i, event := range events {
+		kinds[i] = event.Kind
+	}
+
+	assert.Equal(t, []wire.EventKind{
+		wire.Connected, wire.Connected,
+		wire.ApplyStarted, wire.DependencyResolved, wire.DependencyResolved, wire.ApplyFinished,
+		wire.ResolveCalled,
+	}, kinds)
+
+	assert.Equal(t, reflect.TypeOf(ComponentA{}), events[0].Type)
+	assert.Contains(t, events[0].DeclaredAt, "event_test.go")
+	assert.Equal(t, reflect.TypeOf(ComponentE{}), events[3].Type)
+	assert.Equal(t, "Value1", events[3].Field)
+	assert.Equal(t, reflect.TypeOf(ComponentA{}), events[3].Dependency)
+	assert.Nil(t, events[5].Err)
+}
+
+func TestContainer_Listen_applyFailed(t *testing.T) {
+	var finished wire.Event
+
+	app := wire.New()
+	app.Listen(func(event wire.Event) {
+		if event.Kind == wire.ApplyFinished {
+			finished = event
+		}
+	})
+
+	app.Connect(&ComponentD{})
+
+	assert.Panics(t, func() {
+		app.Apply()
+	})
+	assert.NotNil(t, finished.Err)
+}
+
+func TestContainer_Listen_applyPanicked(t *testing.T) {
+	var finished wire.Event
+
+	app := wire.New()
+	app.Listen(func(event wire.Event) {
+		if event.Kind == wire.ApplyFinished {
+			finished = event
+		}
+	})
+
+	app.Connect(&ComponentA{Value1: "Hi!"})
+	app.Connect(&ComponentE{})
+	app.Decorate(func(v Valuer) Valuer { panic("boom") })
+
+	assert.PanicsWithValue(t, "boom", func() {
+		app.Apply()
+	})
+	assert.EqualError(t, finished.Err, "boom")
+}
+
+func TestSlogListener(t *testing.T) {
+	var buf bytes.Buffer
+
+	app := wire.New()
+	app.Listen(wire.SlogListener(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))))
+	app.Connect(&ComponentA{Value1: "Hi!"})
+	app.Connect(&ComponentE{})
+	app.Apply()
+
+	assert.Contains(t, buf.String(), "level=DEBUG msg=\"wire: connected\" type=wire_test.ComponentA id=\"\"")
+	assert.Contains(t, buf.String(), "msg=\"wire: dependency resolved\" type=wire_test.ComponentE id=\"\"")
+	assert.Contains(t, buf.String(), "field=Value2 dependency=wire_test.ComponentA dependency_id=\"\"")
+	assert.Contains(t, buf.String(), "level=INFO msg=\"wire: apply finished\" duration=")
+}
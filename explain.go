@@ -0,0 +1,246 @@
+package wire
+
+import (
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Explanation describes the components considered to wire a field and why they are chosen or rejected.
+type Explanation struct {
+	Component  string
+	Field      string
+	Type       reflect.Type
+	ID         string
+	Impl       string
+	Config     string
+	Default    string
+	HasDefault bool
+	Candidates []Candidate
+	Err        error
+}
+
+// Candidate describes a component considered to wire a field.
+// Pointer reports whether the component satisfy the field only through it's pointer receiver,
+// Reason describes why a candidate that doesn't match is rejected.
+type Candidate struct {
+	Type       reflect.Type
+	ID         string
+	DeclaredAt string
+	Pointer    bool
+	Matched    bool
+	Reason     string
+}
+
+// String describes the candidate in a single line.
+func (c Candidate) String() string {
+	s := c.Type.String() + " " + strconv.Quote(c.ID)
+	switch {
+	case c.Matched && c.Pointer:
+		s += " matched through pointer receiver"
+	case c.Matched:
+		s += " matched"
+	default:
+		s += " rejected, " + c.Reason
+	}
+
+	return s + ". declared at " + c.DeclaredAt
+}
+
+// String describes the explanation with one candidate per line, followed by the error Apply would panic with.
+func (e Explanation) String() string {
+	var b strings.Builder
+	b.WriteString("field " + e.Field + " of " + e.Component)
+
+	if e.Config != "" {
+		b.WriteString(" is wired from config " + strconv.Quote(e.Config))
+	} else {
+		b.WriteString(" requires " + e.Type.String() + " identified using " + strconv.Quote(e.ID))
+		if e.Impl != "" {
+			b.WriteString(" implemented by " + e.Impl)
+		}
+
+		if len(e.Candidates) == 0 {
+			b.WriteString(", no candidate found")
+		} else {
+			b.WriteString(", candidates:")
+		}
+
+		for _, c := range e.Candidates {
+			b.WriteString("\n\t" + c.String())
+		}
+	}
+
+	if e.Err != nil {
+		b.WriteString("\n" + e.Err.Error())
+	} else if e.HasDefault && !e.matched() {
+		b.WriteString("\nusing default value " + strconv.Quote(e.Default))
+	}
+
+	return b.String()
+}
+
+func (e Explanation) matched() bool {
+	for _, c := range e.Candidates {
+		if c.Matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Explain describes how field of a component is wired, listing every component considered for it.
+// val is only used to determine the type of the component, for example (*Service)(nil) or Service{}.
+//
+// This will panic if the component is not found or field is not wired.
+func (container Container) Explain(val interface{}, field string, id ...string) Explanation {
+	rt := reflect.TypeOf(val)
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	gr, ok := container.components[rt]
+	if !ok {
+		panic(typeNotFoundError{paramType: rt})
+	}
+
+	nam := ""
+	if len(id) > 0 {
+		nam = id[0]
+	}
+
+	c := container.get(rt, gr, nam)
+	if c.alias != nil {
+		c = c.alias
+	}
+
+	for _, dep := range c.dependencies {
+		if dep.name == field {
+			return container.explain(c, dep)
+		}
+	}
+
+	panic(fieldNotFoundError{component: *c, field: field})
+}
+
+// Trace writes explanation of every field wired by Apply to w, nil w disables it.
+func (container Container) Trace(w io.Writer) {
+	*container.trace = w
+}
+
+func (container Container) traced(c *component, dep dependency) {
+	if w := *container.trace; w != nil {
+		io.WriteString(w, container.explain(c, dep).String()+"\n")
+	}
+}
+
+func (container Container) explain(c *component, dep dependency) Explanation {
+	ftyp := c.value.Type().Field(dep.index).Type
+	e := Explanation{
+		Component:  c.value.Type().String() + " " + strconv.Quote(c.id),
+		Field:      dep.name,
+		Type:       dep.typ,
+		ID:         dep.id,
+		Impl:       dep.impl,
+		Config:     dep.config,
+		Default:    dep.def,
+		HasDefault: dep.hasDefault,
+	}
+
+	if dep.config != "" {
+		return e
+	}
+
+	if gr, ok := container.components[dep.typ]; ok && gr.identifies(dep) {
+		for _, cand := range gr {
+			ptr := dep.typ.Kind() == reflect.Interface && !cand.value.Type().Implements(dep.typ)
+			e.Candidates = append(e.Candidates, container.candidate(c, cand, dep, ftyp, ptr, ""))
+		}
+	} else if dep.typ.Kind() == reflect.Interface {
+		for typ, gr := range container.components {
+			// components aliased as interface are already considered through their original.
+			if typ.Kind() == reflect.Interface {
+				continue
+			}
+
+			ctyp := gr[0].value.Type()
+			ptr := !ctyp.Implements(dep.typ)
+			if ptr && !reflect.PtrTo(ctyp).Implements(dep.typ) {
+				continue
+			}
+
+			reason := ""
+			if dep.impl != "" && dep.impl != ctyp.Name() {
+				reason = "implementation name doesn't match " + strconv.Quote(dep.impl)
+			}
+
+			for _, cand := range gr {
+				e.Candidates = append(e.Candidates, container.candidate(c, cand, dep, ftyp, ptr, reason))
+			}
+		}
+
+		sort.Slice(e.Candidates, func(i, j int) bool {
+			a, b := e.Candidates[i], e.Candidates[j]
+			if a.Type != b.Type {
+				return a.Type.String() < b.Type.String()
+			}
+
+			if a.ID != b.ID {
+				return a.ID < b.ID
+			}
+
+			return a.DeclaredAt < b.DeclaredAt
+		})
+	}
+
+	cdep, ptrInterface, err := container.resolve(c, dep)
+	switch {
+	case err != nil && dep.hasDefault && isNotFound(err):
+	case err != nil:
+		e.Err = err
+	case (ftyp.Kind() == reflect.Ptr || ptrInterface) && !cdep.value.CanAddr():
+		e.Err = requiresPointerError{component: *c, dependency: dep, depComponent: *cdep}
+	}
+
+	return e
+}
+
+// candidate describes whether cand can be wired into dep field of c, reason is given when it's already rejected.
+func (container Container) candidate(c *component, cand *component, dep dependency, ftyp reflect.Type, ptr bool, reason string) Candidate {
+	result := Candidate{
+		Type:       cand.value.Type(),
+		ID:         cand.id,
+		DeclaredAt: cand.declaredAt,
+		Pointer:    ptr,
+	}
+
+	switch {
+	case reason != "":
+	case cand.id != dep.id:
+		reason = "id doesn't match"
+	case cand.disabled:
+		reason = "not connected because it's condition is false"
+	case !container.isActive(cand):
+		reason = "only connected for inactive profile " + quoteAll(cand.profiles, ", ")
+	case !visible(c, cand, dep.typ):
+		reason = "private to module " + strconv.Quote(moduleOf(cand).name)
+	case (ftyp.Kind() == reflect.Ptr || ptr) && !cand.value.CanAddr():
+		reason = "not addressable, connect it using reference instead of value"
+	default:
+		result.Matched = true
+	}
+
+	result.Reason = reason
+	return result
+}
+
+func moduleOf(c *component) *Module {
+	if c.alias != nil {
+		return c.alias.module
+	}
+
+	return c.module
+}
@@ -0,0 +1,85 @@
+package wire_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/Fs02/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainer_Explain(t *testing.T) {
+	app := wire.New()
+	app.Connect(&ComponentA{Value1: "Hi!"})
+	app.Connect(&ComponentD{}, "component_d")
+	app.Connect(&ComponentE{})
+
+	explanation := app.Explain((*ComponentE)(nil), "Value1")
+	assert.Equal(t, "Value1", explanation.Field)
+	assert.Equal(t, reflect.TypeOf((*Valuer)(nil)).Elem(), explanation.Type)
+	assert.Nil(t, explanation.Err)
+	assert.Len(t, explanation.Candidates, 2)
+
+	a, d := explanation.Candidates[0], explanation.Candidates[1]
+	assert.Equal(t, reflect.TypeOf(ComponentA{}), a.Type)
+	assert.True(t, a.Matched)
+	assert.False(t, a.Pointer)
+	assert.Contains(t, a.DeclaredAt, "explain_test.go")
+	assert.Equal(t, "component_d", d.ID)
+	assert.False(t, d.Matched)
+	assert.Equal(t, "id doesn't match", d.Reason)
+
+	explanation = app.Explain(ComponentE{}, "Value2")
+	assert.Len(t, explanation.Candidates, 1)
+	assert.True(t, explanation.Candidates[0].Matched)
+	assert.True(t, explanation.Candidates[0].Pointer)
+	assert.Contains(t, explanation.String(), "field Value2 of wire_test.ComponentE \"\" requires wire_test.Setter identified using \"\", candidates:\n"+
+		"\twire_test.ComponentA \"\" matched through pointer receiver. declared at ")
+}
+
+func TestContainer_Explain_rejected(t *testing.T) {
+	var component struct {
+		Value1 Valuer `wire:",ComponentD"`
+		Value2 Setter `wire:""`
+	}
+
+	app := wire.New()
+	app.Connect(ComponentA{Value1: "Hi!"})
+	app.Connect(&component)
+
+	explanation := app.Explain(&component, "Value1")
+	assert.Len(t, explanation.Candidates, 1)
+	assert.False(t, explanation.Candidates[0].Matched)
+	assert.Equal(t, "implementation name doesn't match \"ComponentD\"", explanation.Candidates[0].Reason)
+	assert.NotNil(t, explanation.Err)
+
+	explanation = app.Explain(&component, "Value2")
+	assert.Len(t, explanation.Candidates, 1)
+	assert.False(t, explanation.Candidates[0].Matched)
+	assert.True(t, explanation.Candidates[0].Pointer)
+	assert.Equal(t, "not addressable, connect it using reference instead of value", explanation.Candidates[0].Reason)
+	assert.NotNil(t, explanation.Err)
+}
+
+func TestContainer_Explain_fieldNotFound(t *testing.T) {
+	app := wire.New()
+	app.Connect(&ComponentD{})
+
+	assert.Panics(t, func() {
+		app.Explain((*ComponentD)(nil), "Value3")
+	})
+}
+
+func TestContainer_Trace(t *testing.T) {
+	var buf bytes.Buffer
+
+	app := wire.New()
+	app.Trace(&buf)
+	app.Connect(&ComponentA{Value1: "Hi!"})
+	app.Connect(&ComponentE{})
+	app.Apply()
+
+	assert.Contains(t, buf.String(), "field Value1 of wire_test.ComponentE \"\" requires wire_test.Valuer")
+	assert.Contains(t, buf.String(), "field Value2 of wire_test.ComponentE \"\" requires wire_test.Setter")
+}
@@ -0,0 +1,244 @@
+package wire
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// GraphVersion is the version of graph schema written by WriteJSON.
+// It's incremented whenever the schema changes in incompatible way.
+const GraphVersion = 1
+
+// Graph is a serializable dependency graph of a container.
+type Graph struct {
+	Version    int              `json:"version"`
+	Components []GraphComponent `json:"components"`
+}
+
+// GraphComponent is a serializable description of a component.
+type GraphComponent struct {
+	Key          string            `json:"key"`
+	Type         string            `json:"type"`
+	Package      string            `json:"package,omitempty"`
+	ID           string            `json:"id"`
+	DeclaredAt   string            `json:"declared_at"`
+	Module       string            `json:"module,omitempty"`
+	Profiles     []string          `json:"profiles,omitempty"`
+	Active       bool              `json:"active"`
+	Pointer      bool              `json:"pointer,omitempty"`
+	AliasOf      string            `json:"alias_of,omitempty"`
+	Replaces     string            `json:"replaces,omitempty"`
+	Config       bool              `json:"config,omitempty"`
+	ConfigPrefix string            `json:"config_prefix,omitempty"`
+	Dependencies []GraphDependency `json:"dependencies,omitempty"`
+}
+
+// GraphDependency is a serializable description of a dependency.
+type GraphDependency struct {
+	Field      string   `json:"field"`
+	Type       string   `json:"type"`
+	Package    string   `json:"package,omitempty"`
+	ID         string   `json:"id"`
+	Impl       string   `json:"impl,omitempty"`
+	Config     string   `json:"config,omitempty"`
+	Default    *string  `json:"default,omitempty"`
+	Required   bool     `json:"required,omitempty"`
+	Pointer    bool     `json:"pointer,omitempty"`
+	Component  string   `json:"component,omitempty"`
+	Decorators []string `json:"decorators,omitempty"`
+	Error      string   `json:"error,omitempty"`
+}
+
+// Find a component in graph by it's key.
+func (graph Graph) Find(key string) (GraphComponent, bool) {
+	for _, c := range graph.Components {
+		if c.Key == key {
+			return c, true
+		}
+	}
+
+	return GraphComponent{}, false
+}
+
+// Graph describes the dependency graph of container in serializable form.
+// Declaration sites, including the ones described in errors, are relative to the working directory when possible,
+// so the graph is stable across machines.
+func (container Container) Graph() Graph {
+	comps := container.Components()
+	relativeLocation := relativizer()
+	graph := Graph{
+		Version:    GraphVersion,
+		Components: make([]GraphComponent, len(comps)),
+	}
+
+	keys := graphKeys(comps)
+	for i, c := range comps {
+		gc := GraphComponent{
+			Key:          keys[c],
+			Type:         c.Type.String(),
+			Package:      c.Type.PkgPath(),
+			ID:           c.ID,
+			DeclaredAt:   relativeLocation(c.DeclaredAt),
+			Module:       c.Module,
+			Profiles:     c.Profiles,
+			Active:       c.Active,
+			Pointer:      c.Pointer,
+			Replaces:     relativeLocation(c.Replaces),
+			Config:       c.Config,
+			ConfigPrefix: c.ConfigPrefix,
+		}
+
+		if c.AliasOf != nil {
+			gc.AliasOf = keys[c.AliasOf]
+		}
+
+		for _, dep := range c.Dependencies {
+			gd := GraphDependency{
+				Field:    dep.Field,
+				Type:     dep.Type.String(),
+				Package:  dep.Type.PkgPath(),
+				ID:       dep.ID,
+				Impl:     dep.Impl,
+				Config:   dep.Config,
+				Required: dep.Required,
+				Pointer:  dep.Pointer,
+			}
+
+			if dep.HasDefault {
+				def := dep.Default
+				gd.Default = &def
+			}
+
+			if dep.Component != nil {
+				gd.Component = keys[dep.Component]
+			}
+
+			for _, dec := range dep.Decorators {
+				gd.Decorators = append(gd.Decorators, relativeLocation(dec.DeclaredAt))
+			}
+
+			if dep.Err != nil {
+				// error describes declaration sites of the components involved, which are relativized as well.
+				gd.Error = words.ReplaceAllStringFunc(dep.Err.Error(), relativeLocation)
+			}
+
+			gc.Dependencies = append(gc.Dependencies, gd)
+		}
+
+		graph.Components[i] = gc
+	}
+
+	return graph
+}
+
+// graphKeys identifies components by type and id, components sharing type and id are further identified by their profiles,
+// and by their order when even the profiles are the same.
+func graphKeys(comps []*Component) map[*Component]string {
+	var (
+		keys   = make(map[*Component]string, len(comps))
+		counts = make(map[string]int)
+	)
+
+	for _, c := range comps {
+		counts[c.String()]++
+	}
+
+	for _, c := range comps {
+		keys[c] = c.String()
+		if counts[c.String()] > 1 {
+			keys[c] += " profile " + strings.Join(c.Profiles, ",")
+		}
+	}
+
+	seen := make(map[string]int)
+	for _, c := range comps {
+		key := keys[c]
+		if seen[key]++; seen[key] > 1 {
+			keys[c] = key + " #" + strconv.Itoa(seen[key])
+		}
+	}
+
+	return keys
+}
+
+// WriteJSON writes the dependency graph of container as indented json, suitable to be committed as a golden file.
+func (container Container) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(container.Graph())
+}
+
+// RecordGraph writes the dependency graph of container as json file at path,
+// for example to generate static wiring using wiregen.
+func (container Container) RecordGraph(path string) error {
+	f, err := os.Create(path)
+	if err == nil {
+		err = container.WriteJSON(f)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}
+
+	if err != nil {
+		return graphRecordError{path: path, err: err}
+	}
+
+	return nil
+}
+
+// ReadGraph reads a dependency graph written by WriteJSON.
+func ReadGraph(r io.Reader) (Graph, error) {
+	var graph Graph
+	if err := json.NewDecoder(r).Decode(&graph); err != nil {
+		return graph, err
+	}
+
+	if graph.Version != GraphVersion {
+		return graph, errors.New("wire: unsupported graph version " + strconv.Itoa(graph.Version) +
+			", expected version " + strconv.Itoa(GraphVersion))
+	}
+
+	return graph, nil
+}
+
+var words = regexp.MustCompile(`\S+`)
+
+// relativizer returns a function that makes declaration site inside the current module relative to the working directory.
+func relativizer() func(string) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return func(declaredAt string) string { return declaredAt }
+	}
+
+	root := wd
+	for {
+		if _, err := os.Stat(filepath.Join(root, "go.mod")); err == nil {
+			break
+		}
+
+		parent := filepath.Dir(root)
+		if parent == root {
+			break
+		}
+
+		root = parent
+	}
+
+	return func(declaredAt string) string {
+		if !strings.HasPrefix(declaredAt, root+string(filepath.Separator)) {
+			return declaredAt
+		}
+
+		if rel, err := filepath.Rel(wd, declaredAt); err == nil {
+			return filepath.ToSlash(rel)
+		}
+
+		return declaredAt
+	}
+}
@@ -0,0 +1,109 @@
+package wire_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Fs02/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainer_Graph(t *testing.T) {
+	app := graphContainer()
+	wd, _ := os.Getwd()
+
+	graph := app.Graph()
+	assert.Equal(t, wire.GraphVersion, graph.Version)
+	assert.Len(t, graph.Components, 5)
+
+	a, ok := graph.Find("wire_test.ComponentA \"\"")
+	assert.True(t, ok)
+	assert.Equal(t, "wire_test.ComponentA", a.Type)
+	assert.Equal(t, "github.com/Fs02/wire_test", a.Package)
+	assert.Equal(t, "infra", a.Module)
+	assert.True(t, a.Pointer)
+	assert.True(t, strings.HasPrefix(a.DeclaredAt, "wire_test.go:"))
+
+	alias, _ := graph.Find("wire_test.ComponentA \"alias\"")
+	assert.Equal(t, "wire_test.ComponentA \"\"", alias.AliasOf)
+
+	e, _ := graph.Find("wire_test.ComponentE \"\"")
+	assert.Equal(t, "Value1", e.Dependencies[0].Field)
+	assert.Equal(t, "wire_test.Valuer", e.Dependencies[0].Type)
+	assert.Equal(t, "wire_test.ComponentA \"\"", e.Dependencies[0].Component)
+	assert.Len(t, e.Dependencies[0].Decorators, 1)
+	assert.Equal(t, "", e.Dependencies[0].Error)
+
+	d, _ := graph.Find("wire_test.ComponentD \"component_d\"")
+	assert.Equal(t, "ComponentA", d.Dependencies[0].Impl)
+	assert.Contains(t, d.Dependencies[0].Error, "inactive")
+	assert.Contains(t, d.Dependencies[0].Error, "\n\twire_test.go:")
+	assert.NotContains(t, d.Dependencies[0].Error, wd)
+
+	_, ok = graph.Find("wire_test.ComponentB \"\"")
+	assert.False(t, ok)
+}
+
+func TestContainer_WriteJSON(t *testing.T) {
+	app := wire.New()
+	app.Connect(&ComponentA{Value1: "Hi!"})
+	app.Connect(&ComponentE{})
+
+	var buf bytes.Buffer
+	assert.Nil(t, app.WriteJSON(&buf))
+	assert.Contains(t, buf.String(), "{\n  \"version\": 1,\n  \"components\": [\n")
+
+	graph, err := wire.ReadGraph(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, app.Graph(), graph)
+}
+
+func TestReadGraph_unsupportedVersion(t *testing.T) {
+	_, err := wire.ReadGraph(strings.NewReader(`{"version": 2}`))
+	assert.Equal(t, "wire: unsupported graph version 2, expected version 1", err.Error())
+
+	_, err = wire.ReadGraph(strings.NewReader(`{`))
+	assert.NotNil(t, err)
+}
+
+func TestContainer_Graph_profileVariants(t *testing.T) {
+	app := wire.New()
+	app.Profile("prod").Connect(&ComponentA{Value1: "prod"})
+	app.Profile("dev").Connect(&ComponentA{Value1: "dev"})
+	app.Connect(&ComponentE{})
+
+	graph := app.Graph()
+	dev, ok := graph.Find("wire_test.ComponentA \"\" profile dev")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"dev"}, dev.Profiles)
+
+	prod, ok := graph.Find("wire_test.ComponentA \"\" profile prod")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"prod"}, prod.Profiles)
+}
+
+func TestContainer_RecordGraph(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wire.json")
+
+	app := wire.New()
+	app.Connect(&ComponentA{Value1: "Hi!"})
+	app.Connect(&ComponentE{})
+	assert.Nil(t, app.RecordGraph(path))
+
+	f, err := os.Open(path)
+	assert.Nil(t, err)
+	defer f.Close()
+
+	graph, err := wire.ReadGraph(f)
+	assert.Nil(t, err)
+	assert.Equal(t, app.Graph(), graph)
+
+	e, _ := graph.Find("wire_test.ComponentE \"\"")
+	assert.False(t, e.Dependencies[0].Pointer)
+	assert.True(t, e.Dependencies[1].Pointer)
+
+	assert.Contains(t, app.RecordGraph(filepath.Join(path, "invalid")).Error(), "wire: cannot record graph to ")
+}
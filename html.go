@@ -0,0 +1,174 @@
+package wire
+
+import (
+	"html/template"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WriteHTML writes the dependency graph of container as a self-contained html report.
+// The report lists components with a search box, each dependency links to the component it resolved to,
+// and each component links back to the components depending on it.
+func (container Container) WriteHTML(w io.Writer) error {
+	return htmlReport.Execute(w, newHTMLData(container.Components()))
+}
+
+type htmlData struct {
+	Components []htmlComponent
+}
+
+type htmlComponent struct {
+	Anchor       string
+	Name         string
+	DeclaredAt   string
+	Module       string
+	Profiles     string
+	Active       bool
+	Config       bool
+	AliasOf      *htmlLink
+	Dependencies []htmlDependency
+	UsedBy       []htmlLink
+	Search       string
+}
+
+type htmlDependency struct {
+	Field      string
+	Binding    string
+	Target     *htmlLink
+	Decorators int
+	Kind       string
+	Err        string
+}
+
+type htmlLink struct {
+	Anchor string
+	Name   string
+}
+
+func newHTMLData(comps []*Component) htmlData {
+	var (
+		ids    = nodeIDs(comps)
+		rel    = relativizer()
+		data   = htmlData{Components: make([]htmlComponent, len(comps))}
+		index  = make(map[*Component]int, len(comps))
+		linkTo = func(c *Component) *htmlLink {
+			return &htmlLink{Anchor: ids[c], Name: c.String()}
+		}
+	)
+
+	for i, c := range comps {
+		index[c] = i
+		data.Components[i] = htmlComponent{
+			Anchor:     ids[c],
+			Name:       c.String(),
+			DeclaredAt: rel(c.DeclaredAt),
+			Module:     c.Module,
+			Profiles:   strings.Join(c.Profiles, ", "),
+			Active:     c.Active,
+			Config:     c.Config,
+		}
+	}
+
+	for i, c := range comps {
+		hc := &data.Components[i]
+		if c.AliasOf != nil {
+			hc.AliasOf = linkTo(c.AliasOf)
+		}
+
+		for _, dep := range c.Dependencies {
+			hd := htmlDependency{
+				Field:      dep.Field,
+				Binding:    dep.Type.String() + " " + strconv.Quote(dep.ID),
+				Decorators: len(dep.Decorators),
+			}
+
+			if dep.Impl != "" {
+				hd.Binding += " impl " + dep.Impl
+			}
+
+			if dep.Config != "" {
+				hd.Binding = "config " + strconv.Quote(dep.Config)
+			}
+
+			switch {
+			case dep.Component != nil:
+				hd.Target = linkTo(dep.Component)
+				used := &data.Components[index[dep.Component]]
+				if n := len(used.UsedBy); n == 0 || used.UsedBy[n-1].Anchor != ids[c] {
+					used.UsedBy = append(used.UsedBy, *linkTo(c))
+				}
+			case dep.Err != nil:
+				hd.Kind = errorKind(dep.Err)
+				hd.Err = dep.Err.Error()
+			}
+
+			hc.Dependencies = append(hc.Dependencies, hd)
+		}
+
+		hc.Search = strings.ToLower(strings.Join(strings.Fields(hc.Name+" "+hc.Module+" "+hc.Profiles+" "+hc.DeclaredAt), " "))
+	}
+
+	return data
+}
+
+var htmlReport = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>wire components</title>
+<style>
+body { font-family: Helvetica, Arial, sans-serif; margin: 2em; color: #222; }
+input { width: 100%; padding: .5em; font-size: 1em; box-sizing: border-box; }
+ul.components { list-style: none; padding: 0; }
+li.component { border: 1px solid #ccc; border-radius: 4px; margin: 1em 0; padding: .5em 1em; }
+li.component:target { border-color: #36c; box-shadow: 0 0 4px #36c; }
+li.inactive { color: gray; border-style: dashed; }
+.meta { color: #666; font-size: .9em; }
+.error { color: #c00; }
+code { font-size: .9em; }
+</style>
+</head>
+<body>
+<h1>wire components</h1>
+<input id="search" type="search" placeholder="Search components" autofocus>
+<ul class="components">
+{{- range .Components}}
+<li id="{{.Anchor}}" class="component{{if not .Active}} inactive{{end}}" data-search="{{.Search}}">
+<h3><code>{{.Name}}</code>{{if .Config}} config{{end}}{{if not .Active}} inactive{{end}}</h3>
+<div class="meta">declared at {{.DeclaredAt}}{{if .Module}}, module {{.Module}}{{end}}{{if .Profiles}}, profile {{.Profiles}}{{end}}</div>
+{{- if .AliasOf}}
+<p>alias of <a href="#{{.AliasOf.Anchor}}"><code>{{.AliasOf.Name}}</code></a></p>
+{{- end}}
+{{- if .Dependencies}}
+<p>dependencies:</p>
+<ul>
+{{- range .Dependencies}}
+<li><code>{{.Field}}</code> <code>{{.Binding}}</code>
+{{- if .Target}} &rarr; <a href="#{{.Target.Anchor}}"><code>{{.Target.Name}}</code></a>{{if .Decorators}} decorated {{.Decorators}}x{{end}}
+{{- else if .Err}} <span class="error" title="{{.Err}}">{{.Kind}}</span>{{end}}</li>
+{{- end}}
+</ul>
+{{- end}}
+{{- if .UsedBy}}
+<p>used by:</p>
+<ul>
+{{- range .UsedBy}}
+<li><a href="#{{.Anchor}}"><code>{{.Name}}</code></a></li>
+{{- end}}
+</ul>
+{{- end}}
+</li>
+{{- end}}
+</ul>
+<script>
+document.getElementById("search").addEventListener("input", function (e) {
+	var query = e.target.value.toLowerCase();
+	document.querySelectorAll("li.component").forEach(function (li) {
+		li.style.display = li.dataset.search.indexOf(query) >= 0 ? "" : "none";
+	});
+});
+</script>
+</body>
+</html>
+`))
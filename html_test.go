@@ -0,0 +1,29 @@
+package wire_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Fs02/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainer_WriteHTML(t *testing.T) {
+	app := graphContainer()
+
+	var buf bytes.Buffer
+	assert.Nil(t, app.WriteHTML(&buf))
+
+	html := buf.String()
+	assert.Contains(t, html, "<input id=\"search\" type=\"search\"")
+	assert.Contains(t, html, "<li id=\"c0\" class=\"component inactive\" data-search=\"string &#34;&#34; prod wire_test.go:")
+	assert.Contains(t, html, "<p>alias of <a href=\"#c1\"><code>wire_test.ComponentA &#34;&#34;</code></a></p>")
+	assert.Contains(t, html, "<li><code>Value1</code> <code>wire_test.Valuer &#34;&#34;</code> &rarr; <a href=\"#c1\"><code>wire_test.ComponentA &#34;&#34;</code></a> decorated 1x</li>")
+	assert.Contains(t, html, "<li><code>Value1</code> <code>string &#34;&#34; impl ComponentA</code> <span class=\"error\" title=\"wire: ")
+	assert.Contains(t, html, "<p>used by:</p>\n<ul>\n<li><a href=\"#c4\"><code>wire_test.ComponentE &#34;&#34;</code></a></li>")
+}
+
+func TestContainer_WriteHTML_writeError(t *testing.T) {
+	app := wire.New()
+	assert.NotNil(t, app.WriteHTML(errWriter{}))
+}
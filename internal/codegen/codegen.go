@@ -0,0 +1,160 @@
+// Package codegen contains helpers shared by the code generators of wire.
+package codegen
+
+import (
+	"bytes"
+	"errors"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Imports collects packages imported by generated code.
+type Imports struct {
+	pkg        string
+	importPath string
+	names      map[string]string
+}
+
+// NewImports creates imports of generated package pkg with importPath,
+// types declared in importPath are referenced without qualifier.
+func NewImports(pkg string, importPath string) *Imports {
+	return &Imports{
+		pkg:        pkg,
+		importPath: importPath,
+		names:      make(map[string]string),
+	}
+}
+
+// Qualify returns type as referenced from the generated package, importing it's package when needed.
+// typ is a type name as printed by reflect, for example "repo.Repository", and pkgPath is it's package path.
+func (imports *Imports) Qualify(typ string, pkgPath string) (string, error) {
+	if pkgPath == "" {
+		if strings.Contains(typ, ".") {
+			return "", errors.New("type " + typ + " is not supported, only named and builtin types can be generated")
+		}
+
+		return typ, nil
+	}
+
+	i := strings.Index(typ, ".")
+	if i < 0 || strings.ContainsAny(typ, "[]*") {
+		return "", errors.New("type " + typ + " is not supported, only named and builtin types can be generated")
+	}
+
+	name, typeName := typ[:i], typ[i+1:]
+	if pkgPath == imports.importPath {
+		return typeName, nil
+	}
+
+	if !unicode.IsUpper([]rune(typeName)[0]) {
+		return "", errors.New("unexported type " + typ + " can't be referenced from package " + imports.pkg)
+	}
+
+	return imports.Add(pkgPath, name) + "." + typeName, nil
+}
+
+// Add imports pkgPath with package name, and returns the name used to reference it.
+func (imports *Imports) Add(pkgPath string, name string) string {
+	if imported, ok := imports.names[pkgPath]; ok {
+		return imported
+	}
+
+	// different packages might share the same name.
+	alias := name
+	for n := 2; imports.imported(alias); n++ {
+		alias = name + strconv.Itoa(n)
+	}
+
+	imports.names[pkgPath] = alias
+	return alias
+}
+
+func (imports *Imports) imported(name string) bool {
+	for _, imported := range imports.names {
+		if imported == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Write import declaration sorted by path.
+func (imports *Imports) Write(b *bytes.Buffer) {
+	if len(imports.names) == 0 {
+		return
+	}
+
+	paths := make([]string, 0, len(imports.names))
+	for p := range imports.names {
+		paths = append(paths, p)
+	}
+
+	// standard library packages are grouped first.
+	sort.Slice(paths, func(i, j int) bool {
+		if std(paths[i]) != std(paths[j]) {
+			return std(paths[i])
+		}
+
+		return paths[i] < paths[j]
+	})
+
+	b.WriteString("import (\n")
+	for i, p := range paths {
+		if i > 0 && std(paths[i-1]) != std(p) {
+			b.WriteString("\n")
+		}
+
+		b.WriteString("\t")
+		if name := imports.names[p]; name != path.Base(p) {
+			b.WriteString(name + " ")
+		}
+
+		b.WriteString(strconv.Quote(p) + "\n")
+	}
+
+	b.WriteString(")\n\n")
+}
+
+func std(pkgPath string) bool {
+	return !strings.Contains(strings.SplitN(pkgPath, "/", 2)[0], ".")
+}
+
+// Exported converts s into exported camel case identifier, dropping characters not allowed in identifier.
+func Exported(s string) string {
+	var b strings.Builder
+
+	upper := true
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+
+		b.WriteRune(r)
+	}
+
+	name := b.String()
+	if name != "" && unicode.IsDigit([]rune(name)[0]) {
+		name = "C" + name
+	}
+
+	return name
+}
+
+// TypeName returns name of typ without package qualifier.
+func TypeName(typ string) string {
+	if i := strings.LastIndex(typ, "."); i >= 0 {
+		return typ[i+1:]
+	}
+
+	return typ
+}
@@ -0,0 +1,56 @@
+package codegen
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImports(t *testing.T) {
+	imports := NewImports("main", "example.com/app")
+
+	typ, err := imports.Qualify("repo.Repository", "example.com/app/repo")
+	assert.Nil(t, err)
+	assert.Equal(t, "repo.Repository", typ)
+
+	typ, err = imports.Qualify("repo.Cache", "example.com/cache/repo")
+	assert.Nil(t, err)
+	assert.Equal(t, "repo2.Cache", typ)
+
+	typ, err = imports.Qualify("main.Service", "example.com/app")
+	assert.Nil(t, err)
+	assert.Equal(t, "Service", typ)
+
+	typ, err = imports.Qualify("[]int", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "[]int", typ)
+
+	_, err = imports.Qualify("[]repo.Repository", "")
+	assert.EqualError(t, err, "type []repo.Repository is not supported, only named and builtin types can be generated")
+
+	_, err = imports.Qualify("repo.repository", "example.com/app/repo")
+	assert.EqualError(t, err, "unexported type repo.repository can't be referenced from package main")
+
+	assert.Equal(t, "json", imports.Add("encoding/json", "json"))
+
+	var b bytes.Buffer
+	imports.Write(&b)
+	assert.Equal(t, "import (\n\t\"encoding/json\"\n\n\t\"example.com/app/repo\"\n\trepo2 \"example.com/cache/repo\"\n)\n\n", b.String())
+
+	b.Reset()
+	NewImports("main", "").Write(&b)
+	assert.Equal(t, "", b.String())
+}
+
+func TestExported(t *testing.T) {
+	assert.Equal(t, "ComponentD", Exported("component_d"))
+	assert.Equal(t, "FooUserPrint", Exported("foo.user-print"))
+	assert.Equal(t, "C1st", Exported("1st"))
+	assert.Equal(t, "", Exported(""))
+}
+
+func TestTypeName(t *testing.T) {
+	assert.Equal(t, "Repository", TypeName("repo.Repository"))
+	assert.Equal(t, "string", TypeName("string"))
+}
@@ -0,0 +1,120 @@
+// Package graphdiff compares dependency graphs exported by wire, shared by wiregraph and wiretest so both report the same changes.
+package graphdiff
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Fs02/wire"
+)
+
+// Diff reports changes between two graphs.
+// Declaration sites are ignored since they change with unrelated edits, so replaced declarations, decorators
+// and the text of resolve errors are not compared either.
+func Diff(old wire.Graph, new wire.Graph) []string {
+	var changes []string
+
+	for _, oc := range old.Components {
+		if _, ok := new.Find(oc.Key); !ok {
+			changes = append(changes, "- component "+oc.Key+" removed")
+		}
+	}
+
+	for _, nc := range new.Components {
+		oc, ok := old.Find(nc.Key)
+		if !ok {
+			changes = append(changes, "+ component "+nc.Key+" added")
+			continue
+		}
+
+		changes = append(changes, diffComponent(oc, nc)...)
+	}
+
+	return changes
+}
+
+func diffComponent(old wire.GraphComponent, new wire.GraphComponent) []string {
+	var changes []string
+
+	changed := func(what string, from string, to string) {
+		if from != to {
+			changes = append(changes, "~ component "+new.Key+": "+what+" changed from "+strconv.Quote(from)+" to "+strconv.Quote(to))
+		}
+	}
+
+	changed("module", old.Module, new.Module)
+	changed("profiles", strings.Join(old.Profiles, ","), strings.Join(new.Profiles, ","))
+	changed("active", strconv.FormatBool(old.Active), strconv.FormatBool(new.Active))
+	changed("alias", old.AliasOf, new.AliasOf)
+	changed("config prefix", old.ConfigPrefix, new.ConfigPrefix)
+
+	for _, od := range old.Dependencies {
+		if _, ok := findDependency(new, od.Field); !ok {
+			changes = append(changes, "- field "+new.Key+"."+od.Field+" removed")
+		}
+	}
+
+	for _, nd := range new.Dependencies {
+		od, ok := findDependency(old, nd.Field)
+		if !ok {
+			changes = append(changes, "+ field "+new.Key+"."+nd.Field+" added, "+resolution(nd))
+			continue
+		}
+
+		if binding(od) != binding(nd) {
+			changes = append(changes, "~ field "+new.Key+"."+nd.Field+" binding changed from "+binding(od)+" to "+binding(nd))
+		}
+
+		if resolution(od) != resolution(nd) {
+			changes = append(changes, "~ field "+new.Key+"."+nd.Field+" "+resolution(nd)+", previously "+resolution(od))
+		}
+	}
+
+	return changes
+}
+
+func findDependency(c wire.GraphComponent, field string) (wire.GraphDependency, bool) {
+	for _, dep := range c.Dependencies {
+		if dep.Field == field {
+			return dep, true
+		}
+	}
+
+	return wire.GraphDependency{}, false
+}
+
+// binding describes how a field is tagged.
+func binding(dep wire.GraphDependency) string {
+	b := dep.Type + " " + strconv.Quote(dep.ID)
+	if dep.Impl != "" {
+		b += " impl " + dep.Impl
+	}
+
+	if dep.Config != "" {
+		b = "config " + strconv.Quote(dep.Config)
+	}
+
+	if dep.Default != nil {
+		b += " default " + strconv.Quote(*dep.Default)
+	}
+
+	if dep.Required {
+		b += " required"
+	}
+
+	return "`" + b + "`"
+}
+
+// resolution describes what a field resolves to.
+func resolution(dep wire.GraphDependency) string {
+	switch {
+	case dep.Component != "":
+		return "resolves to " + dep.Component
+	case dep.Error != "":
+		return "fails to resolve"
+	case dep.Config != "":
+		return "resolves to config " + strconv.Quote(dep.Config)
+	}
+
+	return "resolves to default value"
+}
@@ -0,0 +1,78 @@
+package graphdiff
+
+import (
+	"testing"
+
+	"github.com/Fs02/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	def := "10"
+	old := wire.Graph{
+		Version: wire.GraphVersion,
+		Components: []wire.GraphComponent{
+			{Key: "main.A \"\"", DeclaredAt: "main.go:10", Active: true},
+			{Key: "main.B \"\"", DeclaredAt: "main.go:11", Active: true},
+			{Key: "main.C \"\"", DeclaredAt: "main.go:12", Active: true, Dependencies: []wire.GraphDependency{
+				{Field: "A", Type: "main.Valuer", Component: "main.A \"\""},
+				{Field: "B", Type: "*main.B", Component: "main.B \"\""},
+				{Field: "Limit", Config: "limit", Default: &def},
+			}},
+		},
+	}
+	new := wire.Graph{
+		Version: wire.GraphVersion,
+		Components: []wire.GraphComponent{
+			{Key: "main.A \"\"", DeclaredAt: "main.go:20", Active: true},
+			{Key: "main.C \"\"", DeclaredAt: "main.go:22", Profiles: []string{"prod"}, Dependencies: []wire.GraphDependency{
+				{Field: "A", Type: "main.Valuer", Component: "main.D \"\""},
+				{Field: "Limit", Config: "limit", Required: true},
+				{Field: "E", Type: "*main.E", Error: "wire: missing"},
+			}},
+			{Key: "main.D \"\"", DeclaredAt: "main.go:23", Active: true},
+		},
+	}
+
+	assert.Nil(t, Diff(old, old))
+	assert.Equal(t, []string{
+		"- component main.B \"\" removed",
+		"~ component main.C \"\": profiles changed from \"\" to \"prod\"",
+		"~ component main.C \"\": active changed from \"true\" to \"false\"",
+		"- field main.C \"\".B removed",
+		"~ field main.C \"\".A resolves to main.D \"\", previously resolves to main.A \"\"",
+		"~ field main.C \"\".Limit binding changed from `config \"limit\" default \"10\"` to `config \"limit\" required`",
+		"+ field main.C \"\".E added, fails to resolve",
+		"+ component main.D \"\" added",
+	}, Diff(old, new))
+}
+
+func TestDiff_declarationSites(t *testing.T) {
+	old := wire.Graph{
+		Version: wire.GraphVersion,
+		Components: []wire.GraphComponent{
+			{Key: "main.A \"\"", DeclaredAt: "main.go:10", Replaces: "main.go:5", Active: true, Dependencies: []wire.GraphDependency{
+				{Field: "B", Type: "*main.B", Error: "wire: missing dependency declared at main.go:10", Decorators: []string{"main.go:7"}},
+			}},
+		},
+	}
+	new := wire.Graph{
+		Version: wire.GraphVersion,
+		Components: []wire.GraphComponent{
+			{Key: "main.A \"\"", DeclaredAt: "main.go:20", Replaces: "main.go:15", Active: true, Dependencies: []wire.GraphDependency{
+				{Field: "B", Type: "*main.B", Error: "wire: missing dependency declared at main.go:20", Decorators: []string{"main.go:17"}},
+			}},
+		},
+	}
+
+	assert.Nil(t, Diff(old, new))
+}
+
+func TestDiff_profileVariants(t *testing.T) {
+	app := wire.New()
+	app.Profile("prod").Connect("prod")
+	app.Profile("dev").Connect("dev")
+
+	graph := app.Graph()
+	assert.Nil(t, Diff(graph, graph))
+}
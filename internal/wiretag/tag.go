@@ -0,0 +1,71 @@
+// Package wiretag parses `wire` struct tags, shared by wire and it's static analysis tools so they never disagree on a tag.
+package wiretag
+
+import (
+	"strings"
+)
+
+// Tag is a parsed `wire` tag in form of "id,impl", or "config=key" to wire a value from config sources.
+// Tag optionally ends with "required" and "default=value" options, default value is always the last option.
+// Options are only recognized after a comma, so `wire:"required"` still wires the component identified using "required".
+type Tag struct {
+	Values     []string
+	Config     string
+	HasConfig  bool
+	Default    string
+	HasDefault bool
+	Required   bool
+}
+
+// Parse parses tag value tval.
+func Parse(tval string) Tag {
+	var tag Tag
+
+	// default value might contains comma, so it always takes the rest of the tag.
+	if i := defaultIndex(tval); i >= 0 {
+		tag.Default, tag.HasDefault = strings.TrimPrefix(tval[i:], "default="), true
+		tval = strings.TrimSuffix(tval[:i], ",")
+	}
+
+	for i, opt := range strings.Split(tval, ",") {
+		switch {
+		case strings.HasPrefix(opt, "config="):
+			tag.Config, tag.HasConfig = strings.TrimPrefix(opt, "config="), true
+		case opt == "required" && i > 0:
+			tag.Required = true
+		default:
+			tag.Values = append(tag.Values, opt)
+		}
+	}
+
+	return tag
+}
+
+// defaultIndex returns index of "default=" option at the start of tval or right after a comma, or -1 if there's none.
+func defaultIndex(tval string) int {
+	for i := 0; i < len(tval); i++ {
+		if (i == 0 || tval[i-1] == ',') && strings.HasPrefix(tval[i:], "default=") {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// ID returns the first value of the tag, which is the id of the component to be wired.
+func (tag Tag) ID() string {
+	return tag.value(0)
+}
+
+// Impl returns the second value of the tag, which is the name of the implementation to be wired.
+func (tag Tag) Impl() string {
+	return tag.value(1)
+}
+
+func (tag Tag) value(i int) string {
+	if i < len(tag.Values) {
+		return tag.Values[i]
+	}
+
+	return ""
+}
@@ -0,0 +1,42 @@
+package wiretag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		tval string
+		tag  Tag
+	}{
+		{tval: "", tag: Tag{Values: []string{""}}},
+		{tval: "foo,Printer", tag: Tag{Values: []string{"foo", "Printer"}}},
+		{tval: ",required", tag: Tag{Values: []string{""}, Required: true}},
+		{tval: "config=db.port,required", tag: Tag{Config: "db.port", HasConfig: true, Required: true}},
+		{tval: ",default=a,b", tag: Tag{Values: []string{""}, Default: "a,b", HasDefault: true}},
+		{tval: "default=", tag: Tag{Values: []string{""}, HasDefault: true}},
+		{tval: "required", tag: Tag{Values: []string{"required"}}},
+		{tval: "required,required", tag: Tag{Values: []string{"required"}, Required: true}},
+		{tval: "config=app.default=1", tag: Tag{Config: "app.default=1", HasConfig: true}},
+		{tval: "config=app.default=1,default=2", tag: Tag{Config: "app.default=1", HasConfig: true, Default: "2", HasDefault: true}},
+		{tval: "xdefault=1,Printer", tag: Tag{Values: []string{"xdefault=1", "Printer"}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.tval, func(t *testing.T) {
+			assert.Equal(t, test.tag, Parse(test.tval))
+		})
+	}
+}
+
+func TestTag_values(t *testing.T) {
+	tag := Parse("foo,Printer,extra")
+	assert.Equal(t, "foo", tag.ID())
+	assert.Equal(t, "Printer", tag.Impl())
+
+	tag = Parse("")
+	assert.Equal(t, "", tag.ID())
+	assert.Equal(t, "", tag.Impl())
+}
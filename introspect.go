@@ -0,0 +1,197 @@
+package wire
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Component describes a connected component.
+type Component struct {
+	Type         reflect.Type
+	ID           string
+	DeclaredAt   string
+	Module       string
+	Profiles     []string
+	Active       bool
+	Pointer      bool
+	AliasOf      *Component
+	Replaces     string
+	Config       bool
+	ConfigPrefix string
+	Dependencies []Dependency
+}
+
+// String returns type and id of the component.
+func (c Component) String() string {
+	return c.Type.String() + " " + strconv.Quote(c.ID)
+}
+
+// Dependency describes a field of a component to be wired.
+type Dependency struct {
+	Field      string
+	Type       reflect.Type
+	ID         string
+	Impl       string
+	Config     string
+	Default    string
+	HasDefault bool
+	Required   bool
+	Pointer    bool
+	Component  *Component
+	Decorators []Decorator
+	Err        error
+}
+
+// Decorator describes a decorator applied to a dependency.
+type Decorator struct {
+	Type       reflect.Type
+	ID         string
+	DeclaredAt string
+}
+
+// Components describes all components connected to container sorted by type and id,
+// together with the components their dependencies resolved to.
+// Dependency that can't be resolved reports the error Apply would panic with.
+func (container Container) Components() []*Component {
+	_, result := container.describeAll()
+	return result
+}
+
+// describeAll describes all components connected to container, comps are sorted in the same order as their descriptors.
+func (container Container) describeAll() (comps []*component, result []*Component) {
+	descriptors := make(map[*component]*Component)
+
+	for typ, gr := range container.components {
+		for _, c := range gr {
+			comps = append(comps, c)
+			descriptors[c] = container.summarize(typ, c)
+		}
+	}
+
+	sort.SliceStable(comps, func(i, j int) bool {
+		return descriptors[comps[i]].less(descriptors[comps[j]])
+	})
+
+	result = make([]*Component, len(comps))
+	for i, c := range comps {
+		desc := descriptors[c]
+		if c.alias != nil {
+			desc.AliasOf = descriptors[c.alias]
+		}
+
+		for _, dep := range c.dependencies {
+			desc.Dependencies = append(desc.Dependencies, container.describe(c, dep, descriptors))
+		}
+
+		result[i] = desc
+	}
+
+	return comps, result
+}
+
+// summarize describes component c connected as typ without it's dependencies.
+func (container Container) summarize(typ reflect.Type, c *component) *Component {
+	desc := &Component{
+		Type:       typ,
+		ID:         c.id,
+		DeclaredAt: c.declaredAt,
+		Profiles:   c.profiles,
+		Active:     container.isActive(c),
+		Pointer:    c.value.CanAddr(),
+		Replaces:   c.replaced,
+		Config:     c.config != nil,
+	}
+
+	if c.module != nil {
+		desc.Module = c.module.name
+	}
+
+	if c.config != nil {
+		desc.ConfigPrefix = c.config.prefix
+	}
+
+	return desc
+}
+
+// less orders components by type, id, profiles, module and declaration site,
+// so components that only differ by profile are always listed in the same order.
+func (c *Component) less(other *Component) bool {
+	switch {
+	case c.Type.String() != other.Type.String():
+		return c.Type.String() < other.Type.String()
+	case c.Type.PkgPath() != other.Type.PkgPath():
+		return c.Type.PkgPath() < other.Type.PkgPath()
+	case c.ID != other.ID:
+		return c.ID < other.ID
+	case strings.Join(c.Profiles, ",") != strings.Join(other.Profiles, ","):
+		return strings.Join(c.Profiles, ",") < strings.Join(other.Profiles, ",")
+	case c.Module != other.Module:
+		return c.Module < other.Module
+	}
+
+	return c.DeclaredAt < other.DeclaredAt
+}
+
+func (container Container) describe(c *component, dep dependency, descriptors map[*component]*Component) Dependency {
+	desc := Dependency{
+		Field:      dep.name,
+		Type:       dep.typ,
+		ID:         dep.id,
+		Impl:       dep.impl,
+		Config:     dep.config,
+		Default:    dep.def,
+		HasDefault: dep.hasDefault,
+		Required:   dep.required,
+	}
+
+	if dep.config != "" {
+		return desc
+	}
+
+	cdep, ptrInterface, err := container.resolve(c, dep)
+	if err != nil {
+		// missing component is not an error when there's default value.
+		if !dep.hasDefault || !isNotFound(err) {
+			desc.Err = err
+		}
+
+		return desc
+	}
+
+	desc.Component = descriptors[cdep]
+
+	ftyp := c.value.Type().Field(dep.index).Type
+	desc.Pointer = ftyp.Kind() == reflect.Ptr || ptrInterface
+	orig := cdep
+	if orig.alias != nil {
+		orig = orig.alias
+	}
+
+	for _, dec := range container.decorators[ftyp] {
+		if !dec.hasID || dec.id == orig.id {
+			desc.Decorators = append(desc.Decorators, Decorator{
+				Type:       ftyp,
+				ID:         dec.id,
+				DeclaredAt: dec.declaredAt,
+			})
+		}
+	}
+
+	return desc
+}
+
+// errorKind describes why a dependency can't be resolved in a single word.
+func errorKind(err error) string {
+	switch err.(type) {
+	case ambiguousError:
+		return "ambiguous"
+	case privateError:
+		return "private"
+	case inactiveError:
+		return "inactive"
+	}
+
+	return "missing"
+}
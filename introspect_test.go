@@ -0,0 +1,102 @@
+package wire_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Fs02/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainer_Components(t *testing.T) {
+	mod := wire.NewModule("infra")
+	mod.Connect(&ComponentA{Value1: "Hi!"})
+
+	app := wire.New()
+	app.Install(mod)
+	app.Alias((*ComponentA)(nil), "", "alias")
+	app.Profile("prod").Connect("LGTM!")
+	app.Connect(&ComponentE{})
+	app.Connect(&ComponentD{}, "component_d")
+	app.Connect(wire.Config[DBConfig]("db"))
+	app.Decorate(func(v Valuer) Valuer { return v })
+
+	comps := app.Components()
+	assert.Len(t, comps, 6)
+
+	str, a, alias, d, e, config := comps[0], comps[1], comps[2], comps[3], comps[4], comps[5]
+
+	assert.Equal(t, "string \"\"", str.String())
+	assert.Equal(t, []string{"prod"}, str.Profiles)
+	assert.False(t, str.Active)
+
+	assert.Equal(t, reflect.TypeOf(ComponentA{}), a.Type)
+	assert.Equal(t, "", a.ID)
+	assert.Equal(t, "infra", a.Module)
+	assert.True(t, a.Active)
+	assert.Contains(t, a.DeclaredAt, "introspect_test.go")
+
+	assert.Equal(t, "alias", alias.ID)
+	assert.True(t, a == alias.AliasOf)
+
+	assert.Equal(t, "wire_test.DBConfig \"\"", config.String())
+	assert.True(t, config.Config)
+	assert.Equal(t, "db", config.ConfigPrefix)
+
+	assert.Len(t, d.Dependencies, 1)
+	assert.Equal(t, "Value1", d.Dependencies[0].Field)
+	assert.Equal(t, "ComponentA", d.Dependencies[0].Impl)
+	assert.Nil(t, d.Dependencies[0].Component)
+	assert.NotNil(t, d.Dependencies[0].Err)
+
+	assert.Len(t, e.Dependencies, 2)
+	assert.Equal(t, "Value1", e.Dependencies[0].Field)
+	assert.Equal(t, reflect.TypeOf((*Valuer)(nil)).Elem(), e.Dependencies[0].Type)
+	assert.True(t, a == e.Dependencies[0].Component)
+	assert.Nil(t, e.Dependencies[0].Err)
+	assert.Len(t, e.Dependencies[0].Decorators, 1)
+	assert.True(t, a == e.Dependencies[1].Component)
+	assert.Len(t, e.Dependencies[1].Decorators, 0)
+}
+
+func TestContainer_Components_config(t *testing.T) {
+	var component struct {
+		App  string `wire:",required,default=CoolApp"`
+		Port int    `wire:"config=db.port"`
+	}
+
+	app := wire.New()
+	app.Connect(&component)
+
+	comps := app.Components()
+	assert.Len(t, comps, 1)
+	assert.Equal(t, []wire.Dependency{
+		{Field: "App", Type: reflect.TypeOf(""), Default: "CoolApp", HasDefault: true, Required: true},
+		{Field: "Port", Type: reflect.TypeOf(0), Config: "db.port"},
+	}, comps[0].Dependencies)
+}
+
+func TestContainer_Components_profileOrder(t *testing.T) {
+	app := wire.New()
+	app.Connect(1)
+	app.Connect(true)
+	app.Connect(1.5)
+	app.Connect([]int{1})
+	app.Connect(&ComponentA{})
+
+	profiles := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m", "n", "o", "p"}
+	for i := len(profiles) - 1; i >= 0; i-- {
+		app.Profile(profiles[i]).Connect(profiles[i], "store")
+	}
+
+	for i := 0; i < 20; i++ {
+		var ordered []string
+		for _, c := range app.Components() {
+			if c.ID == "store" {
+				ordered = append(ordered, c.Profiles...)
+			}
+		}
+
+		assert.Equal(t, profiles, ordered)
+	}
+}
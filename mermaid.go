@@ -0,0 +1,86 @@
+package wire
+
+import (
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WriteMermaid writes the dependency graph of container as mermaid flowchart, which can be embedded in markdown.
+// It's rendered the same way as WriteDOT.
+func (container Container) WriteMermaid(w io.Writer) error {
+	comps := container.Components()
+	ids := nodeIDs(comps)
+
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	b.WriteString("\tclassDef inactive stroke-dasharray:5 5,color:gray\n")
+	b.WriteString("\tclassDef missing stroke:red,stroke-dasharray:5 5,color:red\n")
+
+	var modules []string
+	grouped := make(map[string][]*Component)
+	for _, c := range comps {
+		if _, ok := grouped[c.Module]; !ok {
+			modules = append(modules, c.Module)
+		}
+
+		grouped[c.Module] = append(grouped[c.Module], c)
+	}
+
+	for i, mod := range modules {
+		indent := "\t"
+		if mod != "" {
+			b.WriteString("\tsubgraph m" + strconv.Itoa(i) + " [" + mermaidQuote("module "+mod) + "]\n")
+			indent = "\t\t"
+		}
+
+		for _, c := range grouped[mod] {
+			b.WriteString(indent + ids[c] + mermaidShape(c) + "\n")
+			if !c.Active {
+				b.WriteString(indent + "class " + ids[c] + " inactive\n")
+			}
+		}
+
+		if mod != "" {
+			b.WriteString("\tend\n")
+		}
+	}
+
+	for _, c := range comps {
+		if c.AliasOf != nil {
+			b.WriteString("\t" + ids[c] + " -. alias .-> " + ids[c.AliasOf] + "\n")
+		}
+
+		for j, dep := range c.Dependencies {
+			switch {
+			case dep.Component != nil:
+				b.WriteString("\t" + ids[c] + " -->|" + mermaidQuote(edgeLabel(dep)) + "| " + ids[dep.Component] + "\n")
+			case dep.Err != nil:
+				missing := ids[c] + "_" + strconv.Itoa(j)
+				b.WriteString("\t" + missing + "[" + mermaidQuote(errorKind(dep.Err)+"\n"+dep.Type.String()+" "+strconv.Quote(dep.ID)) + "]:::missing\n")
+				b.WriteString("\t" + ids[c] + " -->|" + mermaidQuote(edgeLabel(dep)) + "| " + missing + "\n")
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func mermaidShape(c *Component) string {
+	label := mermaidQuote(nodeLabel(c))
+	switch {
+	case c.AliasOf != nil:
+		return "(" + label + ")"
+	case c.Config:
+		return "[/" + label + "/]"
+	}
+
+	return "[" + label + "]"
+}
+
+// mermaidQuote quotes s as mermaid label, characters that have special meaning are escaped as entity codes.
+func mermaidQuote(s string) string {
+	s = strings.NewReplacer("#", "#35;", "\"", "#quot;", "<", "#lt;", ">", "#gt;", "\n", "<br>").Replace(s)
+	return "\"" + s + "\""
+}
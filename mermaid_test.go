@@ -0,0 +1,32 @@
+package wire_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Fs02/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainer_WriteMermaid(t *testing.T) {
+	app := graphContainer()
+
+	var buf bytes.Buffer
+	assert.Nil(t, app.WriteMermaid(&buf))
+
+	mermaid := buf.String()
+	assert.Contains(t, mermaid, "flowchart LR\n")
+	assert.Contains(t, mermaid, "\tsubgraph m1 [\"module infra\"]\n\t\tc1[\"wire_test.ComponentA<br>wire_test.go:")
+	assert.Contains(t, mermaid, "\tc0[\"string<br>profile prod<br>wire_test.go:")
+	assert.Contains(t, mermaid, "\tclass c0 inactive\n")
+	assert.Contains(t, mermaid, "\tc2(\"wire_test.ComponentA<br>#quot;alias#quot;<br>wire_test.go:")
+	assert.Contains(t, mermaid, "\tc2 -. alias .-> c1\n")
+	assert.Contains(t, mermaid, "\tc4 -->|\"Value1<br>decorated 1x\"| c1\n")
+	assert.Contains(t, mermaid, "\tc3_0[\"inactive<br>string #quot;#quot;\"]:::missing\n")
+	assert.Contains(t, mermaid, "\tc3 -->|\"Value1 (ComponentA)\"| c3_0\n")
+}
+
+func TestContainer_WriteMermaid_writeError(t *testing.T) {
+	app := wire.New()
+	assert.NotNil(t, app.WriteMermaid(errWriter{}))
+}
@@ -0,0 +1,78 @@
+package wire
+
+import (
+	"reflect"
+)
+
+// Module groups related components, so they can be installed together into a container.
+type Module struct {
+	name      string
+	container Container
+	includes  []*Module
+	exports   map[reflect.Type]bool
+}
+
+// NewModule creates a named module, optionally including other modules.
+// Included modules are installed together with the module.
+func NewModule(name string, includes ...*Module) *Module {
+	mod := &Module{
+		name:      name,
+		container: New(),
+		includes:  includes,
+	}
+
+	mod.container.module = mod
+	mod.container.callerSkip = 1
+
+	return mod
+}
+
+// Name of the module.
+func (mod *Module) Name() string {
+	return mod.name
+}
+
+// Connect a component to the module, optionally identified by id.
+//
+// This will panic if:
+//   1. Duplicate component found in the module.
+//   2. Possible forgotten `wire` tag found on pointer and interface field.
+//   3. Component that need wiring is passed using value.
+func (mod *Module) Connect(val interface{}, id ...string) {
+	mod.container.Connect(val, id...)
+}
+
+// Include other modules to be installed together with the module.
+func (mod *Module) Include(mods ...*Module) {
+	mod.includes = append(mod.includes, mods...)
+}
+
+// Export types to other modules, types are given as a value or a pointer, for example (*Printer)(nil) or DB{}.
+// Once a module exports any type, it's components are only wired to components of the same module,
+// unless the type of the component or the interface requested is exported.
+func (mod *Module) Export(types ...interface{}) {
+	if mod.exports == nil {
+		mod.exports = make(map[reflect.Type]bool)
+	}
+
+	for _, typ := range types {
+		rt := reflect.TypeOf(typ)
+		if rt.Kind() == reflect.Ptr {
+			rt = rt.Elem()
+		}
+
+		mod.exports[rt] = true
+	}
+}
+
+func (mod *Module) exported(typ reflect.Type) bool {
+	return mod.exports == nil || mod.exports[typ]
+}
+
+func moduleName(mod *Module) string {
+	if mod == nil {
+		return "no module"
+	}
+
+	return "module \"" + mod.name + "\""
+}
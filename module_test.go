@@ -0,0 +1,186 @@
+package wire_test
+
+import (
+	"testing"
+
+	"github.com/Fs02/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModule(t *testing.T) {
+	componentA := ComponentA{Value1: "Hi!"}
+	componentE := ComponentE{}
+
+	infra := wire.NewModule("infra")
+	infra.Connect(&componentA)
+
+	service := wire.NewModule("service", infra)
+	service.Connect(&componentE)
+
+	assert.Equal(t, "service", service.Name())
+
+	app := wire.New()
+	app.Install(service)
+	app.Apply()
+
+	var resolvedE *ComponentE
+	app.Resolve(&resolvedE)
+
+	assert.True(t, &componentE == resolvedE)
+	assert.True(t, &componentA == componentE.Value2)
+}
+
+func TestModule_Include_installedOnce(t *testing.T) {
+	infra := wire.NewModule("infra")
+	infra.Connect(&ComponentA{})
+
+	foo := wire.NewModule("foo", infra)
+	foo.Connect(true)
+
+	boo := wire.NewModule("boo")
+	boo.Include(infra)
+	boo.Connect([]int{1})
+
+	app := wire.New()
+	app.Install(foo)
+
+	assert.NotPanics(t, func() {
+		app.Install(boo)
+	})
+}
+
+func TestModule_Connect_duplicate(t *testing.T) {
+	mod := wire.NewModule("mod")
+	mod.Connect(&ComponentA{})
+
+	assert.Panics(t, func() {
+		mod.Connect(&ComponentA{})
+	})
+}
+
+func TestContainer_Install_twice(t *testing.T) {
+	mod := wire.NewModule("mod")
+	mod.Connect(&ComponentA{})
+
+	app := wire.New()
+	app.Install(mod)
+
+	assert.Panics(t, func() {
+		app.Install(mod)
+	})
+}
+
+func TestContainer_Install_duplicate(t *testing.T) {
+	mod := wire.NewModule("mod")
+	mod.Connect(&ComponentA{})
+
+	app := wire.New()
+	app.Connect(&ComponentA{})
+
+	assert.Panics(t, func() {
+		app.Install(mod)
+	})
+}
+
+func TestContainer_Install_duplicateInclude(t *testing.T) {
+	infra := wire.NewModule("infra")
+	infra.Connect(true)
+
+	mod := wire.NewModule("mod", infra)
+	mod.Connect(&ComponentA{})
+
+	app := wire.New()
+	app.Connect(&ComponentA{})
+
+	assert.Panics(t, func() {
+		app.Install(mod)
+	})
+	assert.Len(t, app.Components(), 1)
+
+	assert.NotPanics(t, func() {
+		app.Install(infra)
+	})
+}
+
+func TestContainer_Install_snapshot(t *testing.T) {
+	mod := wire.NewModule("mod")
+	mod.Connect(&ComponentA{})
+
+	app := wire.New()
+	restore := app.Snapshot()
+	app.Install(mod)
+	restore()
+
+	assert.NotPanics(t, func() {
+		app.Install(mod)
+	})
+}
+
+func TestModule_Export(t *testing.T) {
+	componentA := ComponentA{Value1: "Hi!"}
+	componentC := ComponentC{}
+	componentE := ComponentE{}
+
+	infra := wire.NewModule("infra")
+	infra.Connect(&componentA)
+	infra.Connect(&ComponentA{Value1: "Hello!"}, "hello")
+	infra.Connect(&ComponentB{})
+	infra.Connect(&componentC)
+	infra.Export((*Valuer)(nil), (*Setter)(nil))
+
+	app := wire.New()
+	app.Connect("LGTM!")
+	app.Connect(true)
+	app.Connect([]int{1})
+	app.Connect(&ComponentD{}, "component_d")
+	app.Connect(&componentE)
+	app.Install(infra)
+	app.Apply()
+
+	assert.True(t, &componentA == componentC.Value1)
+	assert.True(t, &componentA == componentE.Value2)
+	assert.Equal(t, componentA, componentE.Value1)
+}
+
+func TestModule_Export_private(t *testing.T) {
+	infra := wire.NewModule("infra")
+	infra.Connect(&ComponentA{})
+	infra.Export((*Setter)(nil))
+
+	app := wire.New()
+	app.Connect(&ComponentC{})
+	app.Install(infra)
+
+	assert.Panics(t, func() {
+		app.Apply()
+	})
+}
+
+func TestModule_Export_privateInterface(t *testing.T) {
+	infra := wire.NewModule("infra")
+	infra.Connect(&ComponentA{})
+	infra.Export((*Setter)(nil))
+
+	app := wire.New()
+	app.Connect(&ComponentE{})
+	app.Install(infra)
+
+	assert.Panics(t, func() {
+		app.Apply()
+	})
+}
+
+func TestModule_Export_privateAlias(t *testing.T) {
+	infra := wire.NewModule("infra")
+	infra.Connect(&ComponentA{})
+	infra.Export((*Setter)(nil))
+
+	app := wire.New()
+	app.Connect(&ComponentE{})
+	app.Install(infra)
+	app.AliasAs((*ComponentA)(nil), "", (*Valuer)(nil), "")
+
+	assert.Panics(t, func() {
+		app.Apply()
+	})
+}
@@ -0,0 +1,108 @@
+package wire
+
+import (
+	"os"
+	"reflect"
+	"strings"
+)
+
+// ProfilesEnv is the environment variable listing active profiles, separated by comma.
+const ProfilesEnv = "WIRE_PROFILES"
+
+// Profile returns a view of container that connects components only for the given profiles.
+// Components of a profile are ignored unless the profile is activated using Activate or ProfilesEnv environment variable.
+//
+//	app.Profile("dev").Connect(&MemoryStore{})
+//	app.Profile("prod").Connect(&PostgresStore{})
+func (container Container) Profile(profiles ...string) Container {
+	container.profiles = profiles
+	container.callerSkip = 0
+	return container
+}
+
+// Activate profiles, in addition to profiles listed in ProfilesEnv environment variable when the container is created.
+// Profiles should be activated before wiring applied.
+func (container Container) Activate(profiles ...string) {
+	for _, profile := range profiles {
+		container.active[profile] = true
+	}
+}
+
+// envProfiles reads profiles listed in ProfilesEnv environment variable.
+func envProfiles() map[string]bool {
+	active := make(map[string]bool)
+	for _, env := range strings.Split(os.Getenv(ProfilesEnv), ",") {
+		if env = strings.TrimSpace(env); env != "" {
+			active[env] = true
+		}
+	}
+
+	return active
+}
+
+func (container Container) isActive(c *component) bool {
+	if c.disabled {
+		return false
+	}
+
+	if len(c.profiles) == 0 {
+		return true
+	}
+
+	for _, profile := range c.profiles {
+		if container.active[profile] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lookup finds active component identified by id,
+// inactive is the component that would match if it's profile is active.
+func (container Container) lookup(gr group, id string) (c *component, inactive *component) {
+	for _, c := range gr {
+		if c.id != id {
+			continue
+		}
+
+		if container.isActive(c) {
+			return c, nil
+		}
+
+		inactive = c
+	}
+
+	return nil, inactive
+}
+
+func (container Container) get(typ reflect.Type, gr group, id string) *component {
+	c, inactive := container.lookup(gr, id)
+	if c != nil {
+		return c
+	}
+
+	if inactive != nil {
+		panic(inactiveError{depComponent: *inactive})
+	}
+
+	panic(idNotFoundError{id: id, paramType: typ, ids: gr.ids()})
+}
+
+// conflicts reports whether both components can be active at the same time,
+// regardless of the condition they are connected with, so duplicates are found whichever way the condition goes.
+func conflicts(a *component, b *component) bool {
+	if len(a.profiles) == 0 || len(b.profiles) == 0 {
+		return true
+	}
+
+	for _, pa := range a.profiles {
+		for _, pb := range b.profiles {
+			if pa == pb {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,148 @@
+package wire_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/Fs02/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainer_Profile(t *testing.T) {
+	componentE := ComponentE{}
+
+	app := wire.New()
+	app.Profile("dev").Connect(&ComponentA{Value1: "Dev!"})
+	app.Profile("prod", "staging").Connect(&ComponentA{Value1: "Prod!"})
+	app.Alias((*ComponentA)(nil), "", "alias")
+	app.Connect(&componentE)
+	app.Activate("staging")
+	app.Apply()
+
+	var resolvedA *ComponentA
+	app.Resolve(&resolvedA, "alias")
+
+	assert.Equal(t, "Prod!", resolvedA.Value1)
+	assert.Equal(t, "Prod!", componentE.Value1.Value())
+	assert.True(t, resolvedA == componentE.Value2)
+}
+
+func TestContainer_Profile_env(t *testing.T) {
+	os.Setenv(wire.ProfilesEnv, "test, dev")
+	defer os.Unsetenv(wire.ProfilesEnv)
+
+	app := wire.New()
+	app.Profile("dev").Connect("Dev!")
+	app.Profile("prod").Connect("Prod!")
+	app.Apply()
+
+	var resolved string
+	app.Resolve(&resolved)
+
+	assert.Equal(t, "Dev!", resolved)
+}
+
+func TestContainer_Profile_duplicate(t *testing.T) {
+	app := wire.New()
+	app.Profile("dev").Connect(&ComponentA{})
+
+	assert.Panics(t, func() {
+		app.Profile("dev", "prod").Connect(&ComponentA{})
+	})
+
+	assert.Panics(t, func() {
+		app.Connect(&ComponentA{})
+	})
+}
+
+func TestContainer_Profile_inactive(t *testing.T) {
+	app := wire.New()
+	app.Profile("prod").Connect(&ComponentA{})
+	app.Connect(&ComponentC{})
+	app.Profile("prod").Connect(&ComponentE{})
+
+	var resolvedA ComponentA
+	assert.Panics(t, func() {
+		app.Resolve(&resolvedA)
+	})
+
+	assert.Panics(t, func() {
+		app.Apply()
+	})
+}
+
+func TestContainer_Profile_inactiveInterface(t *testing.T) {
+	app := wire.New()
+	app.Profile("prod").Connect(&ComponentA{})
+	app.Connect(&ComponentE{})
+
+	assert.Panics(t, func() {
+		app.Apply()
+	})
+}
+
+func TestContainer_Profile_replace(t *testing.T) {
+	app := wire.New()
+	app.Profile("dev").Connect(&ComponentA{Value1: "Dev!"})
+	app.Profile("prod").Connect(&ComponentA{Value1: "Prod!"})
+	app.Replace(&ComponentA{Value1: "Fake!"})
+	app.Activate("prod")
+
+	var resolvedA ComponentA
+	app.Resolve(&resolvedA)
+
+	assert.Equal(t, "Fake!", resolvedA.Value1)
+}
+
+func TestContainer_ConnectIf(t *testing.T) {
+	componentE := ComponentE{}
+
+	app := wire.New()
+	app.ConnectIf(false, &ComponentA{Value1: "Disabled!"}, "disabled")
+	app.ConnectIf(true, &ComponentA{Value1: "Enabled!"})
+	app.ConnectIf(false, &componentE)
+	app.Apply()
+
+	var resolvedA ComponentA
+	app.Resolve(&resolvedA)
+
+	assert.Equal(t, "Enabled!", resolvedA.Value1)
+	assert.Equal(t, ComponentE{}, componentE)
+}
+
+func TestContainer_ConnectIf_disabled(t *testing.T) {
+	app := wire.New()
+	app.ConnectIf(false, &ComponentA{})
+
+	var resolvedA ComponentA
+	assert.Panics(t, func() {
+		app.Resolve(&resolvedA)
+	})
+}
+
+func TestContainer_ConnectIf_duplicate(t *testing.T) {
+	app := wire.New()
+	app.ConnectIf(false, &ComponentA{})
+
+	assert.Panics(t, func() {
+		app.ConnectIf(false, &ComponentA{})
+	})
+
+	assert.Panics(t, func() {
+		app.ConnectIf(true, &ComponentA{})
+	})
+}
+
+func TestContainer_ConnectIf_replace(t *testing.T) {
+	app := wire.New()
+	app.ConnectIf(false, "Disabled!")
+
+	assert.NotPanics(t, func() {
+		app.Replace("Fake!")
+	})
+
+	var resolved string
+	assert.Panics(t, func() {
+		app.Resolve(&resolved)
+	})
+}
@@ -0,0 +1,46 @@
+package wire
+
+import (
+	"context"
+	"log/slog"
+)
+
+// SlogListener logs events to logger, so the activity of a container can be audited in structured logs.
+// Apply is logged at info level, or error level when it fails, every other event is logged at debug level.
+//
+//	app.Listen(wire.SlogListener(slog.Default()))
+func SlogListener(logger *slog.Logger) Listener {
+	return func(event Event) {
+		level := slog.LevelDebug
+		switch {
+		case event.Err != nil:
+			level = slog.LevelError
+		case event.Kind == ApplyStarted || event.Kind == ApplyFinished:
+			level = slog.LevelInfo
+		}
+
+		var attrs []slog.Attr
+		if event.Type != nil {
+			attrs = append(attrs, slog.String("type", event.Type.String()), slog.String("id", event.ID))
+		}
+
+		if event.DeclaredAt != "" {
+			attrs = append(attrs, slog.String("declared_at", event.DeclaredAt))
+		}
+
+		if event.Field != "" {
+			attrs = append(attrs, slog.String("field", event.Field),
+				slog.String("dependency", event.Dependency.String()), slog.String("dependency_id", event.DependencyID))
+		}
+
+		if event.Kind == ApplyFinished {
+			attrs = append(attrs, slog.Duration("duration", event.Duration))
+		}
+
+		if event.Err != nil {
+			attrs = append(attrs, slog.String("error", event.Err.Error()))
+		}
+
+		logger.LogAttrs(context.Background(), level, "wire: "+event.Kind.String(), attrs...)
+	}
+}
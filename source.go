@@ -0,0 +1,128 @@
+package wire
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Source provides configuration values identified by key, nested keys are separated using dot.
+type Source interface {
+	Lookup(key string) (interface{}, bool)
+}
+
+type mapSource map[string]interface{}
+
+func (ms mapSource) Lookup(key string) (interface{}, bool) {
+	val, ok := ms[key]
+	return val, ok
+}
+
+// MapSource creates a config source from values, nested maps are accessible using dot separated key.
+func MapSource(values map[string]interface{}) Source {
+	ms := make(mapSource)
+	flatten(ms, "", values)
+	return ms
+}
+
+// JSONSource creates a config source from json encoded data.
+func JSONSource(data []byte) (Source, error) {
+	return decodeSource(data, json.Unmarshal)
+}
+
+// JSONFile creates a config source from a json file.
+func JSONFile(path string) (Source, error) {
+	return FileSource(path, json.Unmarshal)
+}
+
+// YAMLSource creates a config source from yaml encoded data.
+func YAMLSource(data []byte) (Source, error) {
+	return decodeSource(data, yaml.Unmarshal)
+}
+
+// YAMLFile creates a config source from a yaml file.
+func YAMLFile(path string) (Source, error) {
+	return FileSource(path, yaml.Unmarshal)
+}
+
+// FileSource creates a config source from a file decoded using unmarshal, for example toml.Unmarshal.
+func FileSource(path string, unmarshal func([]byte, interface{}) error) (Source, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := decodeSource(data, unmarshal)
+	if err != nil {
+		return nil, fmt.Errorf("wire: cannot decode config file %s: %v", path, err)
+	}
+
+	return src, nil
+}
+
+func decodeSource(data []byte, unmarshal func([]byte, interface{}) error) (Source, error) {
+	var values map[string]interface{}
+	if err := unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+
+	return MapSource(values), nil
+}
+
+// flatten nested maps into dot separated keys, nested maps themselves are kept accessible.
+func flatten(ms mapSource, prefix string, values map[string]interface{}) {
+	for key, val := range values {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		if nested, ok := normalizeMap(val); ok {
+			val = nested
+			flatten(ms, key, nested)
+		}
+
+		ms[key] = val
+	}
+}
+
+// normalizeMap converts map decoded by json or yaml into map[string]interface{}.
+func normalizeMap(val interface{}) (map[string]interface{}, bool) {
+	switch m := val.(type) {
+	case map[string]interface{}:
+		return m, true
+	case map[interface{}]interface{}:
+		nm := make(map[string]interface{}, len(m))
+		for k, v := range m {
+			nm[fmt.Sprint(k)] = v
+		}
+
+		return nm, true
+	}
+
+	return nil, false
+}
+
+type envSource string
+
+func (prefix envSource) Lookup(key string) (interface{}, bool) {
+	return os.LookupEnv(envName(string(prefix), key))
+}
+
+// EnvSource creates a config source from environment variables.
+// Key is converted to upper case with dot and dash replaced by underscore,
+// for example "db.port" with "APP" prefix is looked up from APP_DB_PORT.
+func EnvSource(prefix string) Source {
+	return envSource(prefix)
+}
+
+func envName(prefix string, key string) string {
+	name := strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(key))
+	if prefix != "" {
+		name = strings.ToUpper(prefix) + "_" + name
+	}
+
+	return name
+}
@@ -0,0 +1,155 @@
+package wire
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// skippedImplementation is an implementation of interface dependency skipped because it's id or implementation name doesn't match.
+type skippedImplementation struct {
+	component  component
+	idMismatch bool
+}
+
+// ids returns sorted ids of components in the group.
+func (gr group) ids() []string {
+	var ids []string
+	for _, c := range gr {
+		ids = append(ids, c.id)
+	}
+
+	return uniqueSorted(ids)
+}
+
+// skipped finds active implementations of interface dep which id or implementation name doesn't match.
+func (container Container) skipped(dep dependency) []skippedImplementation {
+	if dep.typ.Kind() != reflect.Interface {
+		return nil
+	}
+
+	var skipped []skippedImplementation
+	for typ, gr := range container.components {
+		if typ.Kind() == reflect.Interface {
+			continue
+		}
+
+		ctyp := gr[0].value.Type()
+		if !ctyp.Implements(dep.typ) && !reflect.PtrTo(ctyp).Implements(dep.typ) {
+			continue
+		}
+
+		for _, c := range gr {
+			if !container.isActive(c) {
+				continue
+			}
+
+			if dep.impl != "" && dep.impl != ctyp.Name() {
+				skipped = append(skipped, skippedImplementation{component: *c})
+			} else if c.id != dep.id {
+				skipped = append(skipped, skippedImplementation{component: *c, idMismatch: true})
+			}
+		}
+	}
+
+	sort.Slice(skipped, func(i, j int) bool {
+		a, b := skipped[i].component, skipped[j].component
+		if a.value.Type() != b.value.Type() {
+			return a.value.Type().String() < b.value.Type().String()
+		}
+
+		if a.id != b.id {
+			return a.id < b.id
+		}
+
+		return a.declaredAt < b.declaredAt
+	})
+
+	return skipped
+}
+
+// suggest describes the closest ids to id by edit distance, followed by all connected ids.
+func suggest(id string, ids []string) string {
+	if len(ids) == 0 {
+		return ""
+	}
+
+	msg := "."
+	if closest := closest(id, ids); len(closest) != 0 {
+		msg = ", did you mean " + quoteAll(closest, " or ") + "?"
+	}
+
+	return msg + " connected ids: " + quoteAll(ids, ", ")
+}
+
+// closest returns ids with the smallest edit distance to id, ids that are too different are never suggested.
+func closest(id string, ids []string) []string {
+	var (
+		result []string
+		best   = len(id)/2 + 1
+	)
+
+	for _, candidate := range ids {
+		d := distance(id, candidate)
+		switch {
+		case d == 0 || d > best:
+		case d < best:
+			best = d
+			result = []string{candidate}
+		default:
+			result = append(result, candidate)
+		}
+	}
+
+	return result
+}
+
+// distance computes levenshtein distance between a and b.
+func distance(a string, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+func uniqueSorted(values []string) []string {
+	sort.Strings(values)
+
+	result := values[:0]
+	for i, v := range values {
+		if i == 0 || v != values[i-1] {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+func quoteAll(values []string, sep string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+
+	return strings.Join(quoted, sep)
+}
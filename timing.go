@@ -0,0 +1,145 @@
+package wire
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Timing describes how long wiring a component took.
+// Duration excludes the time spent wiring it's dependencies, which are wired before the component itself.
+type Timing struct {
+	Type       reflect.Type
+	ID         string
+	DeclaredAt string
+	Duration   time.Duration
+}
+
+// String returns type and id of the component with it's duration.
+func (t Timing) String() string {
+	return t.Type.String() + " " + strconv.Quote(t.ID) + " " + t.Duration.String()
+}
+
+// TimingReport describes how long the last Apply took to wire each component.
+// Components are sorted from the slowest, critical path is the chain of dependencies with the longest total duration,
+// starting from the component that depends on the rest of the chain.
+type TimingReport struct {
+	Total        time.Duration
+	Components   []Timing
+	CriticalPath []Timing
+}
+
+// String describes the critical path followed by the ten slowest components.
+func (report TimingReport) String() string {
+	var b strings.Builder
+	b.WriteString("wired in " + report.Total.String() + ", critical path:")
+	for _, t := range report.CriticalPath {
+		b.WriteString("\n\t" + t.String())
+	}
+
+	b.WriteString("\nslowest components:")
+	for _, t := range report.Components[:min(len(report.Components), 10)] {
+		b.WriteString("\n\t" + t.String() + " declared at " + t.DeclaredAt)
+	}
+
+	return b.String()
+}
+
+// Timings reports how long the last Apply took to wire each component, components that are not wired are excluded.
+func (container Container) Timings() TimingReport {
+	var (
+		report TimingReport
+		comps  []*component
+	)
+
+	for _, gr := range container.components {
+		for _, c := range gr {
+			if c.alias == nil && c.filled {
+				comps = append(comps, c)
+			}
+		}
+	}
+
+	sort.SliceStable(comps, func(i, j int) bool {
+		a, b := comps[i], comps[j]
+		return container.summarize(a.value.Type(), a).less(container.summarize(b.value.Type(), b))
+	})
+
+	for _, c := range comps {
+		report.Total += c.duration
+		report.Components = append(report.Components, timing(c))
+	}
+
+	sort.SliceStable(report.Components, func(i, j int) bool {
+		return report.Components[i].Duration > report.Components[j].Duration
+	})
+
+	var (
+		longest time.Duration
+		paths   = make(map[*component]criticalPath)
+	)
+
+	for _, c := range comps {
+		if path := container.criticalPath(c, paths); report.CriticalPath == nil || path.total > longest {
+			longest = path.total
+			report.CriticalPath = path.chain
+		}
+	}
+
+	return report
+}
+
+type criticalPath struct {
+	total time.Duration
+	chain []Timing
+}
+
+// criticalPath finds the chain of dependencies of c with the longest total duration,
+// dependencies in a pointer cycle are only counted once.
+func (container Container) criticalPath(c *component, paths map[*component]criticalPath) criticalPath {
+	if path, ok := paths[c]; ok {
+		return path
+	}
+
+	// placeholder that breaks pointer cycles.
+	paths[c] = criticalPath{}
+
+	var longest criticalPath
+	for _, dep := range c.dependencies {
+		if dep.config != "" {
+			continue
+		}
+
+		cdep, _, err := container.resolve(c, dep)
+		if err != nil {
+			continue
+		}
+
+		if cdep.alias != nil {
+			cdep = cdep.alias
+		}
+
+		if path := container.criticalPath(cdep, paths); path.total > longest.total || longest.chain == nil {
+			longest = path
+		}
+	}
+
+	path := criticalPath{
+		total: c.duration + longest.total,
+		chain: append([]Timing{timing(c)}, longest.chain...),
+	}
+
+	paths[c] = path
+	return path
+}
+
+func timing(c *component) Timing {
+	return Timing{
+		Type:       c.value.Type(),
+		ID:         c.id,
+		DeclaredAt: c.declaredAt,
+		Duration:   c.duration,
+	}
+}
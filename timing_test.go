@@ -0,0 +1,42 @@
+package wire_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Fs02/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainer_Timings(t *testing.T) {
+	app := wire.New()
+	app.Connect(&ComponentA{Value1: "Hi!"})
+	app.Connect(&ComponentE{})
+	app.Decorate(func(v Valuer) Valuer {
+		time.Sleep(10 * time.Millisecond)
+		return v
+	})
+	app.Apply()
+
+	report := app.Timings()
+	assert.Len(t, report.Components, 2)
+	assert.Equal(t, reflect.TypeOf(ComponentE{}), report.Components[0].Type)
+	assert.True(t, report.Components[0].Duration >= 10*time.Millisecond)
+	assert.True(t, report.Total >= report.Components[0].Duration)
+
+	assert.Len(t, report.CriticalPath, 2)
+	assert.Equal(t, reflect.TypeOf(ComponentE{}), report.CriticalPath[0].Type)
+	assert.Equal(t, reflect.TypeOf(ComponentA{}), report.CriticalPath[1].Type)
+	assert.Contains(t, report.String(), "critical path:\n\twire_test.ComponentE \"\" ")
+	assert.Contains(t, report.String(), "slowest components:\n\twire_test.ComponentE \"\" ")
+}
+
+func TestContainer_Timings_notApplied(t *testing.T) {
+	app := wire.New()
+	app.Connect(&ComponentA{Value1: "Hi!"})
+
+	report := app.Timings()
+	assert.Empty(t, report.Components)
+	assert.Empty(t, report.CriticalPath)
+}
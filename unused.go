@@ -0,0 +1,59 @@
+package wire
+
+import (
+	"sort"
+)
+
+// use marks c as wired into a field or resolved, an alias also marks it's original.
+// It's safe to be called by concurrent resolves.
+func (c *component) use() {
+	c.used.Store(true)
+	if c.alias != nil {
+		c.alias.used.Store(true)
+	}
+}
+
+// Unused describes active components that are never wired into any field and never resolved.
+// It should be called after wiring applied and components resolved, for example right before a server start listening for request.
+func (container Container) Unused() []*Component {
+	unused := make(map[*component]bool)
+	for _, c := range container.unused() {
+		unused[c] = true
+	}
+
+	var result []*Component
+	comps, descriptors := container.describeAll()
+	for i, c := range comps {
+		if unused[c] {
+			result = append(result, descriptors[i])
+		}
+	}
+
+	return result
+}
+
+// CheckUnused panics if there's an active component that is never wired into any field and never resolved.
+// Unused components are usually dead registrations that can make interface lookups ambiguous.
+func (container Container) CheckUnused() {
+	if unused := container.unused(); len(unused) != 0 {
+		panic(unusedError{components: unused})
+	}
+}
+
+func (container Container) unused() []*component {
+	var unused []*component
+	for _, gr := range container.components {
+		for _, c := range gr {
+			if !c.used.Load() && container.isActive(c) {
+				unused = append(unused, c)
+			}
+		}
+	}
+
+	sort.SliceStable(unused, func(i, j int) bool {
+		a, b := unused[i], unused[j]
+		return container.summarize(a.value.Type(), a).less(container.summarize(b.value.Type(), b))
+	})
+
+	return unused
+}
@@ -0,0 +1,72 @@
+package wire_test
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/Fs02/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainer_Unused(t *testing.T) {
+	app := wire.New()
+	app.Connect(&ComponentA{Value1: "Hi!"})
+	app.Connect(&ComponentA{Value1: "Hello!"}, "hello")
+	app.Connect(&ComponentE{})
+	app.Profile("prod").Connect("LGTM!")
+	app.Apply()
+
+	unused := app.Unused()
+	assert.Len(t, unused, 2)
+	assert.Equal(t, reflect.TypeOf(ComponentA{}), unused[0].Type)
+	assert.Equal(t, "hello", unused[0].ID)
+	assert.Contains(t, unused[0].DeclaredAt, "unused_test.go")
+	assert.Equal(t, reflect.TypeOf(ComponentE{}), unused[1].Type)
+
+	assert.Panics(t, func() {
+		app.CheckUnused()
+	})
+
+	var e ComponentE
+	var hello *ComponentA
+	app.Resolve(&e)
+	app.Resolve(&hello, "hello")
+
+	assert.Empty(t, app.Unused())
+	assert.NotPanics(t, func() {
+		app.CheckUnused()
+	})
+}
+
+func TestContainer_Unused_alias(t *testing.T) {
+	app := wire.New()
+	app.Connect(&ComponentA{Value1: "Hi!"}, "a")
+	app.Alias((*ComponentA)(nil), "a", "b")
+	app.Apply()
+
+	var a ComponentA
+	app.Resolve(&a, "b")
+
+	assert.Empty(t, app.Unused())
+}
+
+func TestContainer_Unused_concurrentResolve(t *testing.T) {
+	app := wire.New()
+	app.Connect(&ComponentA{Value1: "Hi!"})
+	app.Apply()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var a *ComponentA
+			app.Resolve(&a)
+		}()
+	}
+
+	wg.Wait()
+	assert.Empty(t, app.Unused())
+}
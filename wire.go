@@ -2,6 +2,8 @@
 // It's designed to be strict to avoid your go application running without proper dependency injected.
 package wire
 
+import "io"
+
 var global Container
 
 func init() {
@@ -9,6 +11,14 @@ func init() {
 	global.callerSkip = 1
 }
 
+// Global returns global container used by package level functions,
+// for example to serve it using debughttp.Handler or to export it's graph.
+func Global() Container {
+	container := global
+	container.callerSkip = 0
+	return container
+}
+
 // Connect a component, optionally identified by name.
 //
 // This will panic if:
@@ -19,6 +29,101 @@ func Connect(val interface{}, name ...string) {
 	global.Connect(val, name...)
 }
 
+// ConnectIf connects a component only if cond is true, optionally identified by name.
+func ConnectIf(cond bool, val interface{}, name ...string) {
+	global.ConnectIf(cond, val, name...)
+}
+
+// Profile returns a view of global container that connects components only for the given profiles.
+// Components of a profile are ignored unless the profile is activated using Activate or ProfilesEnv environment variable.
+//
+//	wire.Profile("dev").Connect(&MemoryStore{})
+//	wire.Profile("prod").Connect(&PostgresStore{})
+func Profile(profiles ...string) Container {
+	return global.Profile(profiles...)
+}
+
+// Activate profiles of global container, in addition to profiles listed in ProfilesEnv environment variable.
+func Activate(profiles ...string) {
+	global.Activate(profiles...)
+}
+
+// Replace a connected component with the same type and id, usually to substitute a fake in tests.
+//
+// This should be called before wiring applied.
+// This will panic if there's no component to replace.
+func Replace(val interface{}, name ...string) {
+	global.Replace(val, name...)
+}
+
+// Install components of a module and all modules it includes to global container.
+//
+// This will panic if the module is already installed, or any of it's component is already connected.
+func Install(mod *Module) {
+	global.Install(mod)
+}
+
+// Merge imports components of other container into global container.
+//
+// This will panic if both containers have a component with the same type and id.
+func Merge(other Container) {
+	global.Merge(other)
+}
+
+// Snapshot captures the registrations of global container, the returned function restores it to the captured state.
+// It's intended for tests that connect or replace components globally.
+func Snapshot() (restore func()) {
+	return global.Snapshot()
+}
+
+// Alias exposes a connected component under another id without copying it.
+// val is only used to determine the type of the component, for example (*DB)(nil) or DB{}.
+//
+// This will panic if the component is not found or the alias is already taken.
+func Alias(val interface{}, id string, alias string) {
+	global.Alias(val, id, alias)
+}
+
+// AliasAs exposes a connected component as an interface it implements, identified by alias.
+// iface must be a pointer to the interface, for example (*Printer)(nil).
+//
+// This will panic if the component is not found, doesn't implement the interface or the alias is already taken.
+func AliasAs(val interface{}, id string, iface interface{}, alias string) {
+	global.AliasAs(val, id, iface, alias)
+}
+
+// Decorate components of global container with fn before they are wired or resolved, optionally only components identified by id.
+// fn must be a function in form of func(T) T, for example func(Printer) Printer.
+//
+// This will panic if fn is not a valid decorator.
+func Decorate(fn interface{}, id ...string) {
+	global.Decorate(fn, id...)
+}
+
+// Load config sources to global container, to be wired into fields tagged using `wire:"config=key"`.
+// Sources loaded later take precedence over sources loaded earlier.
+func Load(sources ...Source) {
+	global.Load(sources...)
+}
+
+// Listen registers fn to be called for events of global container, listeners are called in the order they are registered.
+func Listen(fn Listener) {
+	global.Listen(fn)
+}
+
+// Explain describes how field of a component in global container is wired, listing every component considered for it.
+// val is only used to determine the type of the component, for example (*Service)(nil) or Service{}.
+//
+// This will panic if the component is not found or field is not wired.
+func Explain(val interface{}, field string, name ...string) Explanation {
+	return global.Explain(val, field, name...)
+}
+
+// Trace writes explanation of every field wired by Apply to w, nil w disables it.
+func Trace(w io.Writer) {
+	global.Trace(w)
+}
+
 // Resolve a component optionally identified by name.
 //
 // This should be called only after wiring applied.
@@ -30,6 +135,17 @@ func Resolve(out interface{}, name ...string) {
 	global.Resolve(out, name...)
 }
 
+// Unused describes active components of global container that are never wired into any field and never resolved.
+// It should be called after wiring applied and components resolved.
+func Unused() []*Component {
+	return global.Unused()
+}
+
+// CheckUnused panics if there's an active component of global container that is never wired into any field and never resolved.
+func CheckUnused() {
+	global.CheckUnused()
+}
+
 // Apply wiring to all components.
 //
 // This will panic if:
@@ -58,6 +58,23 @@ type ComponentE struct {
 	Value2 Setter `wire:""`
 }
 
+// graphContainer connects components covering every kind of node and edge in exported graphs:
+// a module, an alias, an inactive profile, a decorated dependency and a dependency that can't be resolved.
+func graphContainer() wire.Container {
+	mod := wire.NewModule("infra")
+	mod.Connect(&ComponentA{Value1: "Hi!"})
+
+	app := wire.New()
+	app.Install(mod)
+	app.Alias((*ComponentA)(nil), "", "alias")
+	app.Profile("prod").Connect("LGTM!")
+	app.Connect(&ComponentE{})
+	app.Connect(&ComponentD{}, "component_d")
+	app.Decorate(func(v Valuer) Valuer { return v })
+
+	return app
+}
+
 func TestWire(t *testing.T) {
 	componentA := ComponentA{Value1: "Hi!", Value2: 10}
 	componentB := ComponentB{Value1: []int{1}, Value3: "Hello!"}
@@ -131,3 +148,23 @@ func TestWire(t *testing.T) {
 	assert.Equal(t, componentD, *resolvedD)
 	assert.Equal(t, componentE, *resolvedE)
 }
+
+func TestGlobal(t *testing.T) {
+	defer wire.Snapshot()()
+
+	wire.Connect(&ComponentA{Value1: "Hi!"}, "global")
+	wire.Global().Connect(&ComponentA{Value1: "Hello!"}, "global_view")
+
+	found := 0
+	for _, c := range wire.Global().Components() {
+		if c.ID == "global" || c.ID == "global_view" {
+			assert.Contains(t, c.DeclaredAt, "wire_test.go:")
+			found++
+		}
+	}
+
+	assert.Equal(t, 2, found)
+
+	_, ok := wire.Global().Graph().Find("wire_test.ComponentA \"global_view\"")
+	assert.True(t, ok)
+}
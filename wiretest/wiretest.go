@@ -0,0 +1,137 @@
+// Package wiretest provides helpers to test code wired using wire.
+//
+// Tests that connect or replace components should work on their own container,
+// or restore the container they touched once the test completes:
+//
+//	func TestService(t *testing.T) {
+//		app := wiretest.New(t, base)
+//		app.Replace(&FakePrinter{})
+//		wiretest.Apply(t, app)
+//
+//		var service Service
+//		app.Resolve(&service)
+//		wiretest.AssertWired(t, &service)
+//	}
+package wiretest
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Fs02/wire"
+	"github.com/Fs02/wire/internal/graphdiff"
+	"github.com/Fs02/wire/internal/wiretag"
+)
+
+// New returns a clone of base owned by the test, so components connected or replaced by the test don't leak into other tests.
+// base itself is restored when the test and all its subtests complete.
+func New(t testing.TB, base wire.Container) wire.Container {
+	t.Cleanup(base.Snapshot())
+	return base.Clone()
+}
+
+// Global snapshots the global container and restores it when the test and all its subtests complete.
+func Global(t testing.TB) {
+	t.Cleanup(wire.Snapshot())
+}
+
+// Restore snapshots container and restores it when the test and all its subtests complete.
+// Components connected or replaced during the test are undone.
+func Restore(t testing.TB, container wire.Container) {
+	t.Cleanup(container.Snapshot())
+}
+
+// Apply wiring to all components of container, failing the test instead of panicking.
+func Apply(t testing.TB, container wire.Container) {
+	t.Helper()
+
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatal(r)
+		}
+	}()
+
+	container.Apply()
+}
+
+// AssertGraph asserts that container resolves the same graph as recorded in json file at path,
+// for example to check that wiring generated by wiregen is still up to date. Changes are reported the same way as wiregraph diff.
+func AssertGraph(t testing.TB, container wire.Container, path string) bool {
+	t.Helper()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Errorf("wiretest: %v", err)
+		return false
+	}
+	defer f.Close()
+
+	recorded, err := wire.ReadGraph(f)
+	if err != nil {
+		t.Errorf("wiretest: %s: %v", path, err)
+		return false
+	}
+
+	if changes := graphdiff.Diff(recorded, container.Graph()); len(changes) != 0 {
+		t.Errorf("wiretest: graph is different from %s:\n%s", path, strings.Join(changes, "\n"))
+		return false
+	}
+
+	return true
+}
+
+// AssertUsed asserts that every active component of container is wired into a field or resolved,
+// it should be called after wiring applied and components resolved.
+func AssertUsed(t testing.TB, container wire.Container) bool {
+	t.Helper()
+
+	unused := container.Unused()
+	for _, c := range unused {
+		t.Errorf("wiretest: %s is never wired or resolved, declared at %s", c.String(), c.DeclaredAt)
+	}
+
+	return len(unused) == 0
+}
+
+// AssertWired asserts that every pointer, interface and struct field of val tagged with `wire` is wired.
+// Fields bound from config or with default value are skipped, since their wired value might be zero.
+// val must be a struct or a pointer to a struct.
+func AssertWired(t testing.TB, val interface{}) bool {
+	t.Helper()
+
+	rv := reflect.Indirect(reflect.ValueOf(val))
+	if rv.Kind() != reflect.Struct {
+		t.Errorf("wiretest: AssertWired expects a struct or a pointer to a struct, got %T", val)
+		return false
+	}
+
+	wired := true
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+
+		tval, ok := sf.Tag.Lookup("wire")
+		if !ok || tval == "-" || sf.PkgPath != "" {
+			continue
+		}
+
+		if tag := wiretag.Parse(tval); tag.HasConfig || tag.HasDefault {
+			continue
+		}
+
+		switch sf.Type.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Struct:
+		default:
+			continue
+		}
+
+		if rv.Field(i).IsZero() {
+			t.Errorf("wiretest: field %s of %s with type %s is not wired", sf.Name, rt.String(), sf.Type.String())
+			wired = false
+		}
+	}
+
+	return wired
+}
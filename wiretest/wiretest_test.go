@@ -0,0 +1,194 @@
+package wiretest_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Fs02/wire"
+	"github.com/Fs02/wire/wiretest"
+	"github.com/stretchr/testify/assert"
+)
+
+type Printer interface {
+	Print() string
+}
+
+type UserPrint struct {
+	Name string
+}
+
+func (up UserPrint) Print() string {
+	return up.Name
+}
+
+type Service struct {
+	App     string  `wire:""`
+	Printer Printer `wire:""`
+	Retries int     `wire:",default=0"`
+	Ignored *int    `wire:"-"`
+}
+
+type mockT struct {
+	testing.TB
+	errors   int
+	fatals   int
+	cleanups []func()
+}
+
+func (t *mockT) Helper() {}
+
+func (t *mockT) Errorf(format string, args ...interface{}) {
+	t.errors++
+}
+
+func (t *mockT) Fatal(args ...interface{}) {
+	t.fatals++
+}
+
+func (t *mockT) Cleanup(fn func()) {
+	t.cleanups = append(t.cleanups, fn)
+}
+
+func (t *mockT) finish() {
+	for i := len(t.cleanups) - 1; i >= 0; i-- {
+		t.cleanups[i]()
+	}
+}
+
+func base() wire.Container {
+	app := wire.New()
+	app.Connect("CoolApp")
+	app.Connect(UserPrint{Name: "user"})
+	app.Connect(&Service{})
+	return app
+}
+
+func TestGlobal(t *testing.T) {
+	mt := &mockT{}
+	wiretest.Global(mt)
+	wire.Connect(UserPrint{Name: "global"}, "wiretest")
+	mt.finish()
+
+	assert.Panics(t, func() {
+		var up UserPrint
+		wire.Resolve(&up, "wiretest")
+	})
+}
+
+func TestRestore(t *testing.T) {
+	app := base()
+
+	mt := &mockT{}
+	wiretest.Restore(mt, app)
+	app.Replace(UserPrint{Name: "fake"})
+	app.Connect(true)
+	mt.finish()
+
+	var up UserPrint
+	app.Resolve(&up)
+	assert.Equal(t, "user", up.Name)
+
+	assert.Panics(t, func() {
+		var b bool
+		app.Resolve(&b)
+	})
+}
+
+func TestClone(t *testing.T) {
+	app := base()
+	app.Apply()
+
+	clone := app.Clone()
+	clone.Replace(UserPrint{Name: "fake"})
+	wiretest.Apply(t, clone)
+
+	var service, cloned Service
+	app.Resolve(&service)
+	clone.Resolve(&cloned)
+
+	assert.Equal(t, "user", service.Printer.Print())
+	assert.Equal(t, "fake", cloned.Printer.Print())
+}
+
+func TestNew(t *testing.T) {
+	app := base()
+	app.Apply()
+
+	mt := &mockT{}
+	clone := wiretest.New(mt, app)
+	clone.Replace(UserPrint{Name: "fake"})
+	app.Connect(true)
+	wiretest.Apply(t, clone)
+	mt.finish()
+
+	var service, cloned Service
+	app.Resolve(&service)
+	clone.Resolve(&cloned)
+	assert.Equal(t, "user", service.Printer.Print())
+	assert.Equal(t, "fake", cloned.Printer.Print())
+
+	assert.Panics(t, func() {
+		var b bool
+		app.Resolve(&b)
+	})
+}
+
+func TestApply(t *testing.T) {
+	app := wire.New()
+	app.Connect(&Service{})
+
+	mt := &mockT{}
+	wiretest.Apply(mt, app)
+	assert.Equal(t, 1, mt.fatals)
+}
+
+func TestAssertWired(t *testing.T) {
+	app := base()
+	wiretest.Apply(t, app)
+
+	var service Service
+	app.Resolve(&service)
+	assert.True(t, wiretest.AssertWired(t, &service))
+	assert.True(t, wiretest.AssertWired(t, service))
+
+	mt := &mockT{}
+	assert.False(t, wiretest.AssertWired(mt, &Service{}))
+	assert.Equal(t, 1, mt.errors)
+
+	mt = &mockT{}
+	assert.False(t, wiretest.AssertWired(mt, "notstruct"))
+	assert.Equal(t, 1, mt.errors)
+}
+
+func TestAssertUsed(t *testing.T) {
+	app := base()
+	wiretest.Apply(t, app)
+
+	mt := &mockT{}
+	assert.False(t, wiretest.AssertUsed(mt, app))
+	assert.Equal(t, 1, mt.errors)
+
+	var service Service
+	app.Resolve(&service)
+	assert.True(t, wiretest.AssertUsed(t, app))
+}
+
+func TestAssertGraph(t *testing.T) {
+	var (
+		app  = base()
+		path = filepath.Join(t.TempDir(), "wire.json")
+	)
+
+	assert.Nil(t, app.RecordGraph(path))
+
+	mt := &mockT{}
+	assert.True(t, wiretest.AssertGraph(mt, app, path))
+	assert.Equal(t, 0, mt.errors)
+
+	app.Connect(UserPrint{Name: "admin"}, "admin")
+	assert.False(t, wiretest.AssertGraph(mt, app, path))
+	assert.Equal(t, 1, mt.errors)
+
+	assert.False(t, wiretest.AssertGraph(mt, app, filepath.Join(t.TempDir(), "missing.json")))
+	assert.Equal(t, 2, mt.errors)
+}
@@ -0,0 +1,98 @@
+package wirevet
+
+import (
+	"go/types"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Fs02/wire/internal/wiretag"
+)
+
+// tagProblems describes values of wire tag that are ignored or can't be used.
+func tagProblems(tval string, typ types.Type) []string {
+	var (
+		problems []string
+		tag      = wiretag.Parse(tval)
+	)
+
+	if len(tag.Values) > 2 {
+		problems = append(problems, "wire tag has too many values "+strconv.Quote(strings.Join(tag.Values[2:], ","))+
+			", expected id and implementation followed by options")
+	}
+
+	if tag.HasConfig && tag.Config == "" {
+		problems = append(problems, "wire tag has empty config key")
+	}
+
+	if tag.HasConfig && strings.Join(tag.Values, "") != "" {
+		problems = append(problems, "id and implementation in wire tag are ignored for config field")
+	}
+
+	if tag.Impl() != "" && typ != nil && !tag.HasConfig && !types.IsInterface(typ) {
+		problems = append(problems, "implementation "+strconv.Quote(tag.Impl())+
+			" in wire tag is ignored for non interface field")
+	}
+
+	if tag.HasDefault && typ != nil {
+		if err := checkDefault(tag.Default, typ); err != "" {
+			problems = append(problems, "invalid default value "+strconv.Quote(tag.Default)+" in wire tag: "+err)
+		}
+	}
+
+	return problems
+}
+
+// checkDefault reports whether default value can be converted to typ, only types converted from string are checked.
+func checkDefault(def string, typ types.Type) string {
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+
+	if named, ok := typ.(*types.Named); ok && named.Obj().Pkg() != nil &&
+		named.Obj().Pkg().Path() == "time" && named.Obj().Name() == "Duration" {
+		if _, err := time.ParseDuration(def); err != nil {
+			return "cannot parse as duration"
+		}
+
+		return ""
+	}
+
+	if hasUnmarshalText(typ) {
+		return ""
+	}
+
+	switch u := typ.Underlying().(type) {
+	case *types.Basic:
+		var err error
+		switch {
+		case u.Info()&types.IsBoolean != 0:
+			_, err = strconv.ParseBool(def)
+		case u.Info()&types.IsUnsigned != 0:
+			_, err = strconv.ParseUint(strings.TrimSpace(def), 10, 64)
+		case u.Info()&types.IsInteger != 0:
+			_, err = strconv.ParseInt(strings.TrimSpace(def), 10, 64)
+		case u.Info()&types.IsFloat != 0:
+			_, err = strconv.ParseFloat(strings.TrimSpace(def), 64)
+		}
+
+		if err != nil {
+			return "cannot parse as " + u.Name()
+		}
+	case *types.Interface, *types.Signature, *types.Chan, *types.Struct:
+		return "unsupported type " + typ.String()
+	}
+
+	return ""
+}
+
+func hasUnmarshalText(typ types.Type) bool {
+	mset := types.NewMethodSet(types.NewPointer(typ))
+	for i := 0; i < mset.Len(); i++ {
+		if mset.At(i).Obj().Name() == "UnmarshalText" {
+			return true
+		}
+	}
+
+	return false
+}
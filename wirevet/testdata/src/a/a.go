@@ -0,0 +1,63 @@
+package a
+
+import (
+	"time"
+
+	"github.com/Fs02/wire"
+)
+
+type Valuer interface {
+	Value() string
+}
+
+type Repository struct {
+	Name string
+}
+
+type Service struct {
+	Repository *Repository `wire:""`
+	Valuer     Valuer      `wire:",Repository"`
+	Next       *Service    `wire:"-"`
+}
+
+type Handler struct {
+	Service  *Service
+	Valuer   Valuer `wire:"-"`
+	Fallback Valuer
+}
+
+type Report struct {
+	Repository Repository
+}
+
+type Settings struct {
+	Timeout  time.Duration `wire:"config=timeout,default=5s"`
+	Retries  int           `wire:"config=retries,default=three"` // want `invalid default value "three" in wire tag: cannot parse as int`
+	Interval time.Duration `wire:"config=interval,default=soon"` // want `invalid default value "soon" in wire tag: cannot parse as duration`
+	Key      string        `wire:"config="`                      // want `wire tag has empty config key`
+	Host     string        `wire:"host,config=host"`             // want `id and implementation in wire tag are ignored for config field`
+	Name     string        `wire:"name,Impl"`                    // want `implementation "Impl" in wire tag is ignored for non interface field`
+	Extra    Valuer        `wire:"id,Impl,oops"`                 // want `wire tag has too many values "oops", expected id and implementation followed by options`
+	Fallback Valuer        `wire:",default=x"`                   // want `invalid default value "x" in wire tag: unsupported type a.Valuer`
+	debug    bool          `wire:"config=debug"`                 // want `wire tag on unexported field debug is ignored`
+	Level    *uint         `wire:"config=level,required,default=1"`
+}
+
+func connect() {
+	app := wire.New()
+	mod := wire.NewModule("mod")
+
+	wire.Connect(&Repository{})
+	app.Connect(Repository{}, "value")
+	mod.Connect(&Service{})
+	wire.Connect(Service{})                                   // want `a.Service has wire tags but is connected as a value, use a reference instead`
+	app.ConnectIf(true, &Handler{})                           // want `field Service of a.Handler is a nil \*a.Service without wire tag` `field Fallback of a.Handler is a nil a.Valuer without wire tag`
+	app.Replace(&Handler{Service: &Service{}, Fallback: nil}) // want `field Fallback of a.Handler is a nil a.Valuer without wire tag`
+	app.Connect(&Handler{&Service{}, nil, nil})
+	app.Connect(new(Report)) // want `field Repository of a.Report has connected type a.Repository but no wire tag`
+	app.Connect(&Settings{})
+	wire.Connect(wire.Config[Report]("app"))
+
+	var handler Handler
+	app.Connect(&handler)
+}
@@ -0,0 +1,23 @@
+package wire
+
+type Container struct{}
+
+func (container Container) Connect(val interface{}, id ...string)              {}
+func (container Container) ConnectIf(cond bool, val interface{}, id ...string) {}
+func (container Container) Replace(val interface{}, id ...string)              {}
+
+func New() Container { return Container{} }
+
+type Module struct{}
+
+func (mod *Module) Connect(val interface{}, id ...string) {}
+
+func NewModule(name string, includes ...*Module) *Module { return &Module{} }
+
+type ConfigStruct struct{}
+
+func Config[T any](prefix string) ConfigStruct { return ConfigStruct{} }
+
+func Connect(val interface{}, id ...string)              {}
+func ConnectIf(cond bool, val interface{}, id ...string) {}
+func Replace(val interface{}, id ...string)              {}
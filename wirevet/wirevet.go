@@ -0,0 +1,230 @@
+// Package wirevet provides an analyzer that finds invalid wire tags and components that would panic once connected,
+// without running the program:
+//
+//	go vet -vettool=$(which wirevet) ./...
+//
+// Components connected using a composite literal or new are checked for nil pointer or interface field without wire tag,
+// struct field of connected type without wire tag, and tagged component connected as a value.
+// Since values and ids are only known at runtime, the checks are limited to what's visible in a single package.
+package wirevet
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const wirePath = "github.com/Fs02/wire"
+
+// Analyzer reports invalid wire tags and components that would panic when connected.
+var Analyzer = &analysis.Analyzer{
+	Name:     "wirevet",
+	Doc:      "check wire tags and components connected using wire",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// connection is a call connecting a component.
+type connection struct {
+	call *ast.CallExpr
+	arg  ast.Expr
+	typ  types.Type
+	ptr  bool
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	var conns []connection
+	insp.Preorder([]ast.Node{(*ast.StructType)(nil), (*ast.CallExpr)(nil)}, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.StructType:
+			checkTags(pass, n)
+		case *ast.CallExpr:
+			if conn, ok := connectCall(pass, n); ok {
+				conns = append(conns, conn)
+			}
+		}
+	})
+
+	for _, conn := range conns {
+		checkConnection(pass, conn, conns)
+	}
+
+	return nil, nil
+}
+
+// connectCall reports whether call connects a component using Connect, ConnectIf or Replace.
+func connectCall(pass *analysis.Pass, call *ast.CallExpr) (connection, bool) {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != wirePath {
+		return connection{}, false
+	}
+
+	index := 0
+	switch fn.Name() {
+	case "Connect", "Replace":
+	case "ConnectIf":
+		index = 1
+	default:
+		return connection{}, false
+	}
+
+	if len(call.Args) <= index {
+		return connection{}, false
+	}
+
+	conn := connection{call: call, arg: call.Args[index], typ: pass.TypesInfo.TypeOf(call.Args[index])}
+	if conn.typ == nil {
+		return connection{}, false
+	}
+
+	if ptr, ok := conn.typ.Underlying().(*types.Pointer); ok {
+		conn.typ = ptr.Elem()
+		conn.ptr = true
+	}
+
+	return conn, true
+}
+
+// checkConnection reports fields of connected component that would panic when it's connected.
+func checkConnection(pass *analysis.Pass, conn connection, conns []connection) {
+	if named, ok := conn.typ.(*types.Named); ok && named.Obj().Pkg() != nil &&
+		named.Obj().Pkg().Path() == wirePath && named.Obj().Name() == "ConfigStruct" {
+		return
+	}
+
+	st, ok := conn.typ.Underlying().(*types.Struct)
+	if !ok {
+		return
+	}
+
+	assigned, known := assignedFields(pass, conn.arg)
+	tagged := false
+
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		if !field.Exported() {
+			continue
+		}
+
+		if tval, ok := reflect.StructTag(st.Tag(i)).Lookup("wire"); ok {
+			tagged = tagged || tval != "-"
+			continue
+		}
+
+		switch field.Type().Underlying().(type) {
+		case *types.Pointer, *types.Interface:
+			if known && !assigned[field.Name()] {
+				report(pass, conn, field, "field %s of %s is a nil %s without wire tag, "+
+					"add `wire:\"\"` to wire it or `wire:\"-\"` to ignore it", field.Name(), conn.typ, field.Type())
+			}
+		case *types.Struct:
+			if connected(field.Type(), conns) {
+				report(pass, conn, field, "field %s of %s has connected type %s but no wire tag, "+
+					"add `wire:\"\"` to wire it or `wire:\"-\"` to ignore it", field.Name(), conn.typ, field.Type())
+			}
+		}
+	}
+
+	if tagged && !conn.ptr {
+		pass.Reportf(conn.arg.Pos(), "%s has wire tags but is connected as a value, use a reference instead", conn.typ)
+	}
+}
+
+// assignedFields collects fields set by composite literal or new expression,
+// known is false when the connected value is not created in place.
+func assignedFields(pass *analysis.Pass, expr ast.Expr) (assigned map[string]bool, known bool) {
+	expr = ast.Unparen(expr)
+	if unary, ok := expr.(*ast.UnaryExpr); ok {
+		expr = ast.Unparen(unary.X)
+	}
+
+	switch expr := expr.(type) {
+	case *ast.CallExpr:
+		if builtin, ok := typeutil.Callee(pass.TypesInfo, expr).(*types.Builtin); ok && builtin.Name() == "new" {
+			return nil, true
+		}
+	case *ast.CompositeLit:
+		assigned = make(map[string]bool)
+		for _, elt := range expr.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				// every field is set by unkeyed literal.
+				return nil, false
+			}
+
+			if key, ok := kv.Key.(*ast.Ident); ok && !isNil(pass, kv.Value) {
+				assigned[key.Name] = true
+			}
+		}
+
+		return assigned, true
+	}
+
+	return nil, false
+}
+
+func isNil(pass *analysis.Pass, expr ast.Expr) bool {
+	tv, ok := pass.TypesInfo.Types[expr]
+	return ok && tv.IsNil()
+}
+
+// connected reports whether a component with typ is connected in the package.
+func connected(typ types.Type, conns []connection) bool {
+	for _, conn := range conns {
+		if types.Identical(conn.typ, typ) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// report a field problem at the connect call, pointing to the field declaration as related information.
+func report(pass *analysis.Pass, conn connection, field *types.Var, format string, args ...interface{}) {
+	pass.Report(analysis.Diagnostic{
+		Pos:     conn.arg.Pos(),
+		Message: fmt.Sprintf(format, args...),
+		Related: []analysis.RelatedInformation{
+			{Pos: field.Pos(), Message: "field " + field.Name() + " declared here"},
+		},
+	})
+}
+
+// checkTags reports malformed wire tags of the struct fields.
+func checkTags(pass *analysis.Pass, st *ast.StructType) {
+	for _, field := range st.Fields.List {
+		if field.Tag == nil {
+			continue
+		}
+
+		tag, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			continue
+		}
+
+		tval, ok := reflect.StructTag(tag).Lookup("wire")
+		if !ok || tval == "-" {
+			continue
+		}
+
+		typ := pass.TypesInfo.TypeOf(field.Type)
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				pass.Reportf(name.Pos(), "wire tag on unexported field %s is ignored", name.Name)
+			}
+		}
+
+		for _, problem := range tagProblems(tval, typ) {
+			pass.Reportf(field.Tag.Pos(), "%s", problem)
+		}
+	}
+}
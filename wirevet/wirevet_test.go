@@ -0,0 +1,12 @@
+package wirevet_test
+
+import (
+	"testing"
+
+	"github.com/Fs02/wire/wirevet"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), wirevet.Analyzer, "a")
+}